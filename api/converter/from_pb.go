@@ -0,0 +1,57 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/metis-labs/metis-server/api"
+	"github.com/metis-labs/metis-server/server/database"
+)
+
+// ErrInvalidUpdateMask is returned when the update mask has an unknown path.
+var ErrInvalidUpdateMask = errors.New("invalid update mask")
+
+// FromUpdateProjectRequest converts the given Protobuf message to the update
+// of the project. Only the fields in the update mask are updated, and the
+// name is updated if the mask is empty.
+func FromUpdateProjectRequest(req *pb.UpdateProjectRequest) (*database.ProjectUpdate, error) {
+	update := &database.ProjectUpdate{}
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		update.Name = &req.ProjectName
+		return update, nil
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "project_name":
+			update.Name = &req.ProjectName
+		case "description":
+			update.Description = &req.Description
+		case "tags":
+			update.Tags = &req.Tags
+		case "starred":
+			update.Starred = &req.Starred
+		default:
+			return nil, fmt.Errorf("%s: %w", path, ErrInvalidUpdateMask)
+		}
+	}
+
+	return update, nil
+}
@@ -17,27 +17,322 @@
 package converter
 
 import (
+	"encoding/json"
+	"time"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	pb "github.com/metis-labs/metis-server/api"
+	"github.com/metis-labs/metis-server/server/analysis"
+	"github.com/metis-labs/metis-server/server/shapes"
 	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/validation"
 )
 
-// ToProject converts the given model to Protobuf message.
-func ToProject(project *types.ProjectInfo) *pb.Project {
+// ToProject converts the given model to Protobuf message. The document of the
+// project is in the given collection of Yorkie, and the starred flag is of the
+// given user.
+func ToProject(project *types.ProjectInfo, collection string, userID string) *pb.Project {
 	return &pb.Project{
-		Id:        project.ID.String(),
-		Name:      project.Name,
-		CreatedAt: timestamppb.New(project.CreatedAt),
+		Id:             project.ID.String(),
+		Name:           project.Name,
+		Description:    project.Description,
+		CreatedAt:      timestamppb.New(project.CreatedAt),
+		UpdatedAt:      toTimestamp(project.UpdatedAt),
+		LastEditor:     project.LastEditor,
+		OrganizationId: project.OrganizationID.String(),
+		Owner:          project.Owner,
+		Status:         project.Status,
+		DeletedAt:      toTimestamp(project.DeletedAt),
+		ForkedFrom:     project.ForkedFrom.String(),
+		Transfers:      ToTransfers(project.Transfers),
+		Tags:           project.Tags,
+		Starred:        project.IsStarredBy(userID),
+		DocumentKey: &pb.ProjectDocumentKey{
+			Collection: collection,
+			Document:   project.ID.String(),
+		},
 	}
 }
 
 // ToProjects converts the given model to Protobuf message.
-func ToProjects(projects []*types.ProjectInfo) []*pb.Project {
+func ToProjects(projects []*types.ProjectInfo, collection string, userID string) []*pb.Project {
 	var pbProjects []*pb.Project
 	for _, project := range projects {
-		pbProjects = append(pbProjects, ToProject(project))
+		pbProjects = append(pbProjects, ToProject(project, collection, userID))
 	}
 
 	return pbProjects
 }
+
+// ToTransfers converts the given model to Protobuf message.
+func ToTransfers(transfers []*types.Transfer) []*pb.Transfer {
+	var pbTransfers []*pb.Transfer
+	for _, transfer := range transfers {
+		pbTransfers = append(pbTransfers, &pb.Transfer{
+			From:          transfer.From,
+			To:            transfer.To,
+			TransferredBy: transfer.TransferredBy,
+			TransferredAt: timestamppb.New(transfer.TransferredAt),
+		})
+	}
+
+	return pbTransfers
+}
+
+// ToCollaborators converts the owner and the collaborators of the given model to
+// Protobuf message.
+func ToCollaborators(project *types.ProjectInfo) []*pb.Collaborator {
+	pbCollaborators := []*pb.Collaborator{{
+		UserId: project.Owner,
+		Role:   string(types.RoleOwner),
+	}}
+	for _, collaborator := range project.Collaborators {
+		pbCollaborators = append(pbCollaborators, &pb.Collaborator{
+			UserId: collaborator.UserID,
+			Role:   string(collaborator.Role),
+		})
+	}
+
+	return pbCollaborators
+}
+
+// ToOrganization converts the given model to Protobuf message.
+func ToOrganization(organization *types.OrganizationInfo) *pb.Organization {
+	var pbMembers []*pb.Member
+	for _, member := range organization.Members {
+		pbMembers = append(pbMembers, &pb.Member{
+			UserId: member.UserID,
+			Role:   string(member.Role),
+		})
+	}
+
+	return &pb.Organization{
+		Id:        organization.ID.String(),
+		Name:      organization.Name,
+		Members:   pbMembers,
+		CreatedAt: timestamppb.New(organization.CreatedAt),
+	}
+}
+
+// ToOrganizations converts the given model to Protobuf message.
+func ToOrganizations(organizations []*types.OrganizationInfo) []*pb.Organization {
+	var pbOrganizations []*pb.Organization
+	for _, organization := range organizations {
+		pbOrganizations = append(pbOrganizations, ToOrganization(organization))
+	}
+
+	return pbOrganizations
+}
+
+// ToInvite converts the given model to Protobuf message.
+func ToInvite(invite *types.InviteInfo) *pb.Invite {
+	return &pb.Invite{
+		Id:        invite.ID.String(),
+		ProjectId: invite.ProjectID.String(),
+		Token:     invite.Token,
+		Role:      string(invite.Role),
+		MaxUses:   int32(invite.MaxUses),
+		Uses:      int32(invite.Uses),
+		CreatedBy: invite.CreatedBy,
+		ExpiresAt: timestamppb.New(invite.ExpiresAt),
+		CreatedAt: timestamppb.New(invite.CreatedAt),
+	}
+}
+
+// ToInvites converts the given model to Protobuf message.
+func ToInvites(invites []*types.InviteInfo) []*pb.Invite {
+	var pbInvites []*pb.Invite
+	for _, invite := range invites {
+		pbInvites = append(pbInvites, ToInvite(invite))
+	}
+
+	return pbInvites
+}
+
+// ToAPIKey converts the given model to Protobuf message. The hash of the key
+// is not included.
+func ToAPIKey(apiKey *types.APIKeyInfo) *pb.APIKey {
+	return &pb.APIKey{
+		Id:         apiKey.ID.String(),
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scope:      string(apiKey.Scope),
+		LastUsedAt: toTimestamp(apiKey.LastUsedAt),
+		ExpiresAt:  toTimestamp(apiKey.ExpiresAt),
+		CreatedAt:  timestamppb.New(apiKey.CreatedAt),
+	}
+}
+
+// ToAPIKeys converts the given model to Protobuf message.
+func ToAPIKeys(apiKeys []*types.APIKeyInfo) []*pb.APIKey {
+	var pbAPIKeys []*pb.APIKey
+	for _, apiKey := range apiKeys {
+		pbAPIKeys = append(pbAPIKeys, ToAPIKey(apiKey))
+	}
+
+	return pbAPIKeys
+}
+
+// ToTemplate converts the given model to Protobuf message.
+func ToTemplate(template *types.TemplateInfo) *pb.Template {
+	return &pb.Template{
+		Id:        template.ID.String(),
+		Name:      template.Name,
+		Owner:     template.Owner,
+		Contents:  template.Contents,
+		Public:    template.Public,
+		CreatedAt: timestamppb.New(template.CreatedAt),
+	}
+}
+
+// ToTemplates converts the given model to Protobuf message.
+func ToTemplates(templates []*types.TemplateInfo) []*pb.Template {
+	var pbTemplates []*pb.Template
+	for _, template := range templates {
+		pbTemplates = append(pbTemplates, ToTemplate(template))
+	}
+
+	return pbTemplates
+}
+
+// ToDiagnostics converts the given model to Protobuf message.
+func ToDiagnostics(diagnostics []*validation.Diagnostic) []*pb.Diagnostic {
+	var pbDiagnostics []*pb.Diagnostic
+	for _, diagnostic := range diagnostics {
+		severity := pb.Diagnostic_ERROR
+		if diagnostic.Severity == validation.SeverityWarning {
+			severity = pb.Diagnostic_WARNING
+		}
+
+		pbDiagnostics = append(pbDiagnostics, &pb.Diagnostic{
+			Severity:  severity,
+			Code:      diagnostic.Code,
+			Message:   diagnostic.Message,
+			NetworkId: diagnostic.NetworkID,
+			BlockId:   diagnostic.BlockID,
+			LinkId:    diagnostic.LinkID,
+		})
+	}
+
+	return pbDiagnostics
+}
+
+// ToBlockTypeSpecs converts the given model to Protobuf message.
+func ToBlockTypeSpecs(specs []*types.BlockSpec) ([]*pb.BlockTypeSpec, error) {
+	var pbSpecs []*pb.BlockTypeSpec
+	for _, spec := range specs {
+		var aliases []string
+		for _, alias := range spec.Aliases {
+			aliases = append(aliases, string(alias))
+		}
+
+		var pbParams []*pb.ParameterSpec
+		for _, param := range spec.Parameters {
+			pbParam, err := toParameterSpec(param)
+			if err != nil {
+				return nil, err
+			}
+			pbParams = append(pbParams, pbParam)
+		}
+
+		pbSpecs = append(pbSpecs, &pb.BlockTypeSpec{
+			Type:       string(spec.Type),
+			Aliases:    aliases,
+			TorchClass: spec.TorchClass,
+			Repeatable: spec.Repeatable,
+			Parameters: pbParams,
+		})
+	}
+
+	return pbSpecs, nil
+}
+
+func toParameterSpec(param *types.ParameterSpec) (*pb.ParameterSpec, error) {
+	pbParam := &pb.ParameterSpec{
+		Name:     param.Name,
+		Type:     string(param.Type),
+		Required: param.Required,
+	}
+
+	if param.Default != nil {
+		defaultValue, err := json.Marshal(param.Default)
+		if err != nil {
+			return nil, err
+		}
+		pbParam.DefaultValue = string(defaultValue)
+	}
+	if param.Min != nil {
+		pbParam.Min = wrapperspb.Double(*param.Min)
+	}
+	if param.Max != nil {
+		pbParam.Max = wrapperspb.Double(*param.Max)
+	}
+
+	return pbParam, nil
+}
+
+// ToBlockShapes converts the given model to Protobuf message.
+func ToBlockShapes(blockShapes []*shapes.BlockShape) []*pb.BlockShape {
+	var pbBlockShapes []*pb.BlockShape
+	for _, blockShape := range blockShapes {
+		pbBlockShape := &pb.BlockShape{
+			NetworkId: blockShape.NetworkID,
+			BlockId:   blockShape.BlockID,
+		}
+		for _, d := range blockShape.Shape {
+			pbBlockShape.Shape = append(pbBlockShape.Shape, int64(d))
+		}
+		if blockShape.Err != nil {
+			pbBlockShape.Error = blockShape.Err.Error()
+		}
+
+		pbBlockShapes = append(pbBlockShapes, pbBlockShape)
+	}
+
+	return pbBlockShapes
+}
+
+// ToNetworkStats converts the given model to Protobuf message.
+func ToNetworkStats(networkStats []*analysis.NetworkStats) []*pb.NetworkStats {
+	var pbNetworkStats []*pb.NetworkStats
+	for _, stats := range networkStats {
+		pbNetworkStats = append(pbNetworkStats, &pb.NetworkStats{
+			NetworkId: stats.NetworkID,
+			Params:    stats.Params,
+			Macs:      stats.MACs,
+		})
+	}
+
+	return pbNetworkStats
+}
+
+// ToBlockStats converts the given model to Protobuf message.
+func ToBlockStats(blockStats []*analysis.BlockStats) []*pb.BlockStats {
+	var pbBlockStats []*pb.BlockStats
+	for _, stats := range blockStats {
+		pbStats := &pb.BlockStats{
+			NetworkId: stats.NetworkID,
+			BlockId:   stats.BlockID,
+			Params:    stats.Params,
+			Macs:      stats.MACs,
+		}
+		if stats.Err != nil {
+			pbStats.Error = stats.Err.Error()
+		}
+
+		pbBlockStats = append(pbBlockStats, pbStats)
+	}
+
+	return pbBlockStats
+}
+
+// toTimestamp converts the given time to Protobuf message. The zero time is
+// converted to nil.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
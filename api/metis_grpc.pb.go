@@ -20,8 +20,40 @@ const _ = grpc.SupportPackageIsVersion7
 type MetisClient interface {
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	ListDeletedProjects(ctx context.Context, in *ListDeletedProjectsRequest, opts ...grpc.CallOption) (*ListDeletedProjectsResponse, error)
+	RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error)
+	GetProjectContents(ctx context.Context, in *GetProjectContentsRequest, opts ...grpc.CallOption) (*GetProjectContentsResponse, error)
+	DuplicateProject(ctx context.Context, in *DuplicateProjectRequest, opts ...grpc.CallOption) (*DuplicateProjectResponse, error)
+	TransferProject(ctx context.Context, in *TransferProjectRequest, opts ...grpc.CallOption) (*TransferProjectResponse, error)
+	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error)
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error)
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+	ListBuiltinTemplates(ctx context.Context, in *ListBuiltinTemplatesRequest, opts ...grpc.CallOption) (*ListBuiltinTemplatesResponse, error)
+	SaveProjectAsTemplate(ctx context.Context, in *SaveProjectAsTemplateRequest, opts ...grpc.CallOption) (*SaveProjectAsTemplateResponse, error)
+	GenerateCode(ctx context.Context, in *GenerateCodeRequest, opts ...grpc.CallOption) (*GenerateCodeResponse, error)
+	ValidateProject(ctx context.Context, in *ValidateProjectRequest, opts ...grpc.CallOption) (*ValidateProjectResponse, error)
+	InferShapes(ctx context.Context, in *InferShapesRequest, opts ...grpc.CallOption) (*InferShapesResponse, error)
+	AnalyzeProject(ctx context.Context, in *AnalyzeProjectRequest, opts ...grpc.CallOption) (*AnalyzeProjectResponse, error)
+	ListBlockTypes(ctx context.Context, in *ListBlockTypesRequest, opts ...grpc.CallOption) (*ListBlockTypesResponse, error)
 }
 
 type metisClient struct {
@@ -50,6 +82,15 @@ func (c *metisClient) ListProjects(ctx context.Context, in *ListProjectsRequest,
 	return out, nil
 }
 
+func (c *metisClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/GetProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *metisClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
 	out := new(UpdateProjectResponse)
 	err := c.cc.Invoke(ctx, "/api.Metis/UpdateProject", in, out, opts...)
@@ -68,14 +109,325 @@ func (c *metisClient) DeleteProject(ctx context.Context, in *DeleteProjectReques
 	return out, nil
 }
 
+func (c *metisClient) ListDeletedProjects(ctx context.Context, in *ListDeletedProjectsRequest, opts ...grpc.CallOption) (*ListDeletedProjectsResponse, error) {
+	out := new(ListDeletedProjectsResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListDeletedProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error) {
+	out := new(RestoreProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/RestoreProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) GetProjectContents(ctx context.Context, in *GetProjectContentsRequest, opts ...grpc.CallOption) (*GetProjectContentsResponse, error) {
+	out := new(GetProjectContentsResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/GetProjectContents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) DuplicateProject(ctx context.Context, in *DuplicateProjectRequest, opts ...grpc.CallOption) (*DuplicateProjectResponse, error) {
+	out := new(DuplicateProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/DuplicateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) TransferProject(ctx context.Context, in *TransferProjectRequest, opts ...grpc.CallOption) (*TransferProjectResponse, error) {
+	out := new(TransferProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/TransferProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error) {
+	out := new(AddCollaboratorResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/AddCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error) {
+	out := new(RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/RemoveCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListCollaborators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/CreateOrganization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListOrganizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error) {
+	out := new(AddOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/AddOrganizationMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error) {
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/RemoveOrganizationMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error) {
+	out := new(CreateInviteResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/CreateInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error) {
+	out := new(AcceptInviteResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteResponse, error) {
+	out := new(RevokeInviteResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/RevokeInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
+	out := new(CreateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/CreateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/GetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error) {
+	out := new(UpdateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/UpdateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/DeleteTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListBuiltinTemplates(ctx context.Context, in *ListBuiltinTemplatesRequest, opts ...grpc.CallOption) (*ListBuiltinTemplatesResponse, error) {
+	out := new(ListBuiltinTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListBuiltinTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) SaveProjectAsTemplate(ctx context.Context, in *SaveProjectAsTemplateRequest, opts ...grpc.CallOption) (*SaveProjectAsTemplateResponse, error) {
+	out := new(SaveProjectAsTemplateResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/SaveProjectAsTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) GenerateCode(ctx context.Context, in *GenerateCodeRequest, opts ...grpc.CallOption) (*GenerateCodeResponse, error) {
+	out := new(GenerateCodeResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/GenerateCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ValidateProject(ctx context.Context, in *ValidateProjectRequest, opts ...grpc.CallOption) (*ValidateProjectResponse, error) {
+	out := new(ValidateProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ValidateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) InferShapes(ctx context.Context, in *InferShapesRequest, opts ...grpc.CallOption) (*InferShapesResponse, error) {
+	out := new(InferShapesResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/InferShapes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) AnalyzeProject(ctx context.Context, in *AnalyzeProjectRequest, opts ...grpc.CallOption) (*AnalyzeProjectResponse, error) {
+	out := new(AnalyzeProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/AnalyzeProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisClient) ListBlockTypes(ctx context.Context, in *ListBlockTypesRequest, opts ...grpc.CallOption) (*ListBlockTypesResponse, error) {
+	out := new(ListBlockTypesResponse)
+	err := c.cc.Invoke(ctx, "/api.Metis/ListBlockTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetisServer is the server API for Metis service.
 // All implementations must embed UnimplementedMetisServer
 // for forward compatibility
 type MetisServer interface {
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	ListDeletedProjects(context.Context, *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error)
+	RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error)
+	GetProjectContents(context.Context, *GetProjectContentsRequest) (*GetProjectContentsResponse, error)
+	DuplicateProject(context.Context, *DuplicateProjectRequest) (*DuplicateProjectResponse, error)
+	TransferProject(context.Context, *TransferProjectRequest) (*TransferProjectResponse, error)
+	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
+	ListBuiltinTemplates(context.Context, *ListBuiltinTemplatesRequest) (*ListBuiltinTemplatesResponse, error)
+	SaveProjectAsTemplate(context.Context, *SaveProjectAsTemplateRequest) (*SaveProjectAsTemplateResponse, error)
+	GenerateCode(context.Context, *GenerateCodeRequest) (*GenerateCodeResponse, error)
+	ValidateProject(context.Context, *ValidateProjectRequest) (*ValidateProjectResponse, error)
+	InferShapes(context.Context, *InferShapesRequest) (*InferShapesResponse, error)
+	AnalyzeProject(context.Context, *AnalyzeProjectRequest) (*AnalyzeProjectResponse, error)
+	ListBlockTypes(context.Context, *ListBlockTypesRequest) (*ListBlockTypesResponse, error)
 	mustEmbedUnimplementedMetisServer()
 }
 
@@ -89,12 +441,108 @@ func (UnimplementedMetisServer) CreateProject(context.Context, *CreateProjectReq
 func (UnimplementedMetisServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
+func (UnimplementedMetisServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
 func (UnimplementedMetisServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
 }
 func (UnimplementedMetisServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedMetisServer) ListDeletedProjects(context.Context, *ListDeletedProjectsRequest) (*ListDeletedProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedProjects not implemented")
+}
+func (UnimplementedMetisServer) RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProject not implemented")
+}
+func (UnimplementedMetisServer) GetProjectContents(context.Context, *GetProjectContentsRequest) (*GetProjectContentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectContents not implemented")
+}
+func (UnimplementedMetisServer) DuplicateProject(context.Context, *DuplicateProjectRequest) (*DuplicateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateProject not implemented")
+}
+func (UnimplementedMetisServer) TransferProject(context.Context, *TransferProjectRequest) (*TransferProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferProject not implemented")
+}
+func (UnimplementedMetisServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedMetisServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedMetisServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedMetisServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedMetisServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedMetisServer) AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationMember not implemented")
+}
+func (UnimplementedMetisServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (UnimplementedMetisServer) CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedMetisServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedMetisServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedMetisServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedMetisServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedMetisServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedMetisServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedMetisServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedMetisServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedMetisServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedMetisServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedMetisServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedMetisServer) ListBuiltinTemplates(context.Context, *ListBuiltinTemplatesRequest) (*ListBuiltinTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuiltinTemplates not implemented")
+}
+func (UnimplementedMetisServer) SaveProjectAsTemplate(context.Context, *SaveProjectAsTemplateRequest) (*SaveProjectAsTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProjectAsTemplate not implemented")
+}
+func (UnimplementedMetisServer) GenerateCode(context.Context, *GenerateCodeRequest) (*GenerateCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCode not implemented")
+}
+func (UnimplementedMetisServer) ValidateProject(context.Context, *ValidateProjectRequest) (*ValidateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateProject not implemented")
+}
+func (UnimplementedMetisServer) InferShapes(context.Context, *InferShapesRequest) (*InferShapesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InferShapes not implemented")
+}
+func (UnimplementedMetisServer) AnalyzeProject(context.Context, *AnalyzeProjectRequest) (*AnalyzeProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeProject not implemented")
+}
+func (UnimplementedMetisServer) ListBlockTypes(context.Context, *ListBlockTypesRequest) (*ListBlockTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockTypes not implemented")
+}
 func (UnimplementedMetisServer) mustEmbedUnimplementedMetisServer() {}
 
 // UnsafeMetisServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +592,24 @@ func _Metis_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metis_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/GetProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Metis_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProjectRequest)
 	if err := dec(in); err != nil {
@@ -180,29 +646,945 @@ func _Metis_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// Metis_ServiceDesc is the grpc.ServiceDesc for Metis service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Metis_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Metis",
-	HandlerType: (*MetisServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProject",
-			Handler:    _Metis_CreateProject_Handler,
-		},
-		{
-			MethodName: "ListProjects",
-			Handler:    _Metis_ListProjects_Handler,
-		},
-		{
-			MethodName: "UpdateProject",
-			Handler:    _Metis_UpdateProject_Handler,
-		},
+func _Metis_ListDeletedProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListDeletedProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListDeletedProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListDeletedProjects(ctx, req.(*ListDeletedProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_RestoreProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).RestoreProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/RestoreProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).RestoreProject(ctx, req.(*RestoreProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_GetProjectContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).GetProjectContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/GetProjectContents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).GetProjectContents(ctx, req.(*GetProjectContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_DuplicateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).DuplicateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/DuplicateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).DuplicateProject(ctx, req.(*DuplicateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_TransferProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).TransferProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/TransferProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).TransferProject(ctx, req.(*TransferProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).AddCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/AddCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/RemoveCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/CreateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListOrganizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).AddOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/AddOrganizationMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).AddOrganizationMember(ctx, req.(*AddOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/RemoveOrganizationMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/CreateInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/CreateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/GetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/UpdateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/DeleteTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListBuiltinTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBuiltinTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListBuiltinTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListBuiltinTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListBuiltinTemplates(ctx, req.(*ListBuiltinTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_SaveProjectAsTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProjectAsTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).SaveProjectAsTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/SaveProjectAsTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).SaveProjectAsTemplate(ctx, req.(*SaveProjectAsTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_GenerateCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).GenerateCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/GenerateCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).GenerateCode(ctx, req.(*GenerateCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ValidateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ValidateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ValidateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ValidateProject(ctx, req.(*ValidateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_InferShapes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InferShapesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).InferShapes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/InferShapes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).InferShapes(ctx, req.(*InferShapesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_AnalyzeProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).AnalyzeProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/AnalyzeProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).AnalyzeProject(ctx, req.(*AnalyzeProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metis_ListBlockTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisServer).ListBlockTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Metis/ListBlockTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisServer).ListBlockTypes(ctx, req.(*ListBlockTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Metis_ServiceDesc is the grpc.ServiceDesc for Metis service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Metis_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Metis",
+	HandlerType: (*MetisServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProject",
+			Handler:    _Metis_CreateProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _Metis_ListProjects_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _Metis_GetProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _Metis_UpdateProject_Handler,
+		},
 		{
 			MethodName: "DeleteProject",
 			Handler:    _Metis_DeleteProject_Handler,
 		},
+		{
+			MethodName: "ListDeletedProjects",
+			Handler:    _Metis_ListDeletedProjects_Handler,
+		},
+		{
+			MethodName: "RestoreProject",
+			Handler:    _Metis_RestoreProject_Handler,
+		},
+		{
+			MethodName: "GetProjectContents",
+			Handler:    _Metis_GetProjectContents_Handler,
+		},
+		{
+			MethodName: "DuplicateProject",
+			Handler:    _Metis_DuplicateProject_Handler,
+		},
+		{
+			MethodName: "TransferProject",
+			Handler:    _Metis_TransferProject_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _Metis_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _Metis_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _Metis_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _Metis_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _Metis_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "AddOrganizationMember",
+			Handler:    _Metis_AddOrganizationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _Metis_RemoveOrganizationMember_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _Metis_CreateInvite_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Metis_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _Metis_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _Metis_ListInvites_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Metis_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _Metis_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Metis_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _Metis_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _Metis_ListTemplates_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _Metis_GetTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _Metis_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _Metis_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "ListBuiltinTemplates",
+			Handler:    _Metis_ListBuiltinTemplates_Handler,
+		},
+		{
+			MethodName: "SaveProjectAsTemplate",
+			Handler:    _Metis_SaveProjectAsTemplate_Handler,
+		},
+		{
+			MethodName: "GenerateCode",
+			Handler:    _Metis_GenerateCode_Handler,
+		},
+		{
+			MethodName: "ValidateProject",
+			Handler:    _Metis_ValidateProject_Handler,
+		},
+		{
+			MethodName: "InferShapes",
+			Handler:    _Metis_InferShapes_Handler,
+		},
+		{
+			MethodName: "AnalyzeProject",
+			Handler:    _Metis_AnalyzeProject_Handler,
+		},
+		{
+			MethodName: "ListBlockTypes",
+			Handler:    _Metis_ListBlockTypes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "metis.proto",
+}
+
+// MetisAdminClient is the client API for MetisAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MetisAdminClient interface {
+	ListProjects(ctx context.Context, in *AdminListProjectsRequest, opts ...grpc.CallOption) (*AdminListProjectsResponse, error)
+	DeleteProject(ctx context.Context, in *AdminDeleteProjectRequest, opts ...grpc.CallOption) (*AdminDeleteProjectResponse, error)
+	RestoreProject(ctx context.Context, in *AdminRestoreProjectRequest, opts ...grpc.CallOption) (*AdminRestoreProjectResponse, error)
+	TransferProject(ctx context.Context, in *AdminTransferProjectRequest, opts ...grpc.CallOption) (*AdminTransferProjectResponse, error)
+	GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error)
+}
+
+type metisAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetisAdminClient(cc grpc.ClientConnInterface) MetisAdminClient {
+	return &metisAdminClient{cc}
+}
+
+func (c *metisAdminClient) ListProjects(ctx context.Context, in *AdminListProjectsRequest, opts ...grpc.CallOption) (*AdminListProjectsResponse, error) {
+	out := new(AdminListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/api.MetisAdmin/ListProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisAdminClient) DeleteProject(ctx context.Context, in *AdminDeleteProjectRequest, opts ...grpc.CallOption) (*AdminDeleteProjectResponse, error) {
+	out := new(AdminDeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.MetisAdmin/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisAdminClient) RestoreProject(ctx context.Context, in *AdminRestoreProjectRequest, opts ...grpc.CallOption) (*AdminRestoreProjectResponse, error) {
+	out := new(AdminRestoreProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.MetisAdmin/RestoreProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisAdminClient) TransferProject(ctx context.Context, in *AdminTransferProjectRequest, opts ...grpc.CallOption) (*AdminTransferProjectResponse, error) {
+	out := new(AdminTransferProjectResponse)
+	err := c.cc.Invoke(ctx, "/api.MetisAdmin/TransferProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metisAdminClient) GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error) {
+	out := new(GetSystemStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.MetisAdmin/GetSystemStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetisAdminServer is the server API for MetisAdmin service.
+// All implementations must embed UnimplementedMetisAdminServer
+// for forward compatibility
+type MetisAdminServer interface {
+	ListProjects(context.Context, *AdminListProjectsRequest) (*AdminListProjectsResponse, error)
+	DeleteProject(context.Context, *AdminDeleteProjectRequest) (*AdminDeleteProjectResponse, error)
+	RestoreProject(context.Context, *AdminRestoreProjectRequest) (*AdminRestoreProjectResponse, error)
+	TransferProject(context.Context, *AdminTransferProjectRequest) (*AdminTransferProjectResponse, error)
+	GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error)
+	mustEmbedUnimplementedMetisAdminServer()
+}
+
+// UnimplementedMetisAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedMetisAdminServer struct {
+}
+
+func (UnimplementedMetisAdminServer) ListProjects(context.Context, *AdminListProjectsRequest) (*AdminListProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (UnimplementedMetisAdminServer) DeleteProject(context.Context, *AdminDeleteProjectRequest) (*AdminDeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedMetisAdminServer) RestoreProject(context.Context, *AdminRestoreProjectRequest) (*AdminRestoreProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProject not implemented")
+}
+func (UnimplementedMetisAdminServer) TransferProject(context.Context, *AdminTransferProjectRequest) (*AdminTransferProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferProject not implemented")
+}
+func (UnimplementedMetisAdminServer) GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStats not implemented")
+}
+func (UnimplementedMetisAdminServer) mustEmbedUnimplementedMetisAdminServer() {}
+
+// UnsafeMetisAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetisAdminServer will
+// result in compilation errors.
+type UnsafeMetisAdminServer interface {
+	mustEmbedUnimplementedMetisAdminServer()
+}
+
+func RegisterMetisAdminServer(s grpc.ServiceRegistrar, srv MetisAdminServer) {
+	s.RegisterService(&MetisAdmin_ServiceDesc, srv)
+}
+
+func _MetisAdmin_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisAdminServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MetisAdmin/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisAdminServer).ListProjects(ctx, req.(*AdminListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetisAdmin_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisAdminServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MetisAdmin/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisAdminServer).DeleteProject(ctx, req.(*AdminDeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetisAdmin_RestoreProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRestoreProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisAdminServer).RestoreProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MetisAdmin/RestoreProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisAdminServer).RestoreProject(ctx, req.(*AdminRestoreProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetisAdmin_TransferProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTransferProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisAdminServer).TransferProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MetisAdmin/TransferProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisAdminServer).TransferProject(ctx, req.(*AdminTransferProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetisAdmin_GetSystemStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetisAdminServer).GetSystemStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MetisAdmin/GetSystemStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetisAdminServer).GetSystemStats(ctx, req.(*GetSystemStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetisAdmin_ServiceDesc is the grpc.ServiceDesc for MetisAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetisAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.MetisAdmin",
+	HandlerType: (*MetisAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProjects",
+			Handler:    _MetisAdmin_ListProjects_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _MetisAdmin_DeleteProject_Handler,
+		},
+		{
+			MethodName: "RestoreProject",
+			Handler:    _MetisAdmin_RestoreProject_Handler,
+		},
+		{
+			MethodName: "TransferProject",
+			Handler:    _MetisAdmin_TransferProject_Handler,
+		},
+		{
+			MethodName: "GetSystemStats",
+			Handler:    _MetisAdmin_GetSystemStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metis.proto",
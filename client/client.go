@@ -18,11 +18,15 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/metis-labs/metis-server/api"
+	"github.com/metis-labs/metis-server/server/types"
 )
 
 const (
@@ -31,37 +35,49 @@ const (
 
 // Client is a normal client that can communicate with the Server.
 type Client struct {
-	conn   *grpc.ClientConn
-	client pb.MetisClient
+	conn        *grpc.ClientConn
+	client      pb.MetisClient
+	adminClient pb.MetisAdminClient
 }
 
 // Option configures how we set up the client.
 type Option struct {
-	UserID   string
+	// Token is the access token sent to the server.
+	Token string
+
+	// UserID is sent instead of Token to the server running in the insecure
+	// development mode.
+	UserID string
+
 	CertFile string
 }
 
 // Dial creates an instance of Client.
 func Dial(rpcAddr string, opts ...Option) (*Client, error) {
-	var userID string
-	if len(opts) > 0 && opts[0].UserID != "" {
-		userID = opts[0].UserID
+	var token string
+	if len(opts) > 0 {
+		token = opts[0].Token
+		if token == "" {
+			token = opts[0].UserID
+		}
 	}
 
 	conn, err := grpc.Dial(
 		rpcAddr,
 		grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(unaryInterceptor(userID)),
+		grpc.WithUnaryInterceptor(unaryInterceptor(token)),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	client := pb.NewMetisClient(conn)
+	adminClient := pb.NewMetisAdminClient(conn)
 
 	return &Client{
-		conn:   conn,
-		client: client,
+		conn:        conn,
+		client:      client,
+		adminClient: adminClient,
 	}, nil
 }
 
@@ -85,17 +101,132 @@ func (c *Client) CreateProject(ctx context.Context, name string) (*pb.Project, e
 	return res.Project, nil
 }
 
+// CreateProjectFromTemplate creates a new project of the given name whose
+// contents are seeded from the given template.
+func (c *Client) CreateProjectFromTemplate(
+	ctx context.Context,
+	name string,
+	templateID string,
+) (*pb.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.CreateProject(ctx, &pb.CreateProjectRequest{
+		ProjectName: name,
+		TemplateId:  templateID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Project, nil
+}
+
+// CreateProjectFromBuiltinTemplate creates a new project of the given name
+// whose contents are seeded from the built-in template of the given name.
+func (c *Client) CreateProjectFromBuiltinTemplate(
+	ctx context.Context,
+	name string,
+	builtinTemplate string,
+) (*pb.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.CreateProject(ctx, &pb.CreateProjectRequest{
+		ProjectName:     name,
+		BuiltinTemplate: builtinTemplate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Project, nil
+}
+
+// CreateOrganizationProject creates a new project of the given name owned by
+// the given organization.
+func (c *Client) CreateOrganizationProject(
+	ctx context.Context,
+	name string,
+	organizationID string,
+) (*pb.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.CreateProject(ctx, &pb.CreateProjectRequest{
+		ProjectName:    name,
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Project, nil
+}
+
 // ListProjects returns the list of clients.
 func (c *Client) ListProjects(ctx context.Context) ([]*pb.Project, error) {
+	return c.ListProjectsInScope(ctx, "")
+}
+
+// ListProjectsInScope returns the list of projects in the given scope, one of
+// "all", "personal" and "org:<organization id>". All pages are listed.
+func (c *Client) ListProjectsInScope(ctx context.Context, scope string) ([]*pb.Project, error) {
+	var projects []*pb.Project
+	it := c.IterateProjects(ListProjectsOptions{Scope: scope})
+	for {
+		project, err := it.Next(ctx)
+		if err == Done {
+			return projects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+}
+
+// ListProjectsPage returns the page of projects of the given token and the
+// token of the next page. The first page is returned if pageToken is empty,
+// and the returned token is empty if there is no next page.
+func (c *Client) ListProjectsPage(
+	ctx context.Context,
+	opts ListProjectsOptions,
+	pageToken string,
+) ([]*pb.Project, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListProjects(ctx, &pb.ListProjectsRequest{
+		Scope:        opts.Scope,
+		PageSize:     int32(opts.PageSize),
+		PageToken:    pageToken,
+		SortBy:       opts.SortBy,
+		Descending:   opts.Descending,
+		NameContains: opts.NameContains,
+		Tag:          opts.Tag,
+		Starred:      opts.Starred,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.Projects, res.NextPageToken, nil
+}
+
+// GetProject returns the metadata of the given project.
+func (c *Client) GetProject(ctx context.Context, projectID string) (*pb.Project, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	res, err := c.client.ListProjects(ctx, &pb.ListProjectsRequest{})
+	res, err := c.client.GetProject(ctx, &pb.GetProjectRequest{
+		ProjectId: projectID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Projects, nil
+	return res.Project, nil
 }
 
 // UpdateProject updates the given project.
@@ -110,6 +241,45 @@ func (c *Client) UpdateProject(ctx context.Context, projectID string, projectNam
 	return err
 }
 
+// SetProjectDescription updates the description of the given project.
+func (c *Client) SetProjectDescription(ctx context.Context, projectID string, description string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.UpdateProject(ctx, &pb.UpdateProjectRequest{
+		ProjectId:   projectID,
+		Description: description,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+	return err
+}
+
+// SetProjectTags replaces the tags of the given project.
+func (c *Client) SetProjectTags(ctx context.Context, projectID string, tags []string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.UpdateProject(ctx, &pb.UpdateProjectRequest{
+		ProjectId:  projectID,
+		Tags:       tags,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	})
+	return err
+}
+
+// StarProject stars or unstars the given project for the user.
+func (c *Client) StarProject(ctx context.Context, projectID string, starred bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.UpdateProject(ctx, &pb.UpdateProjectRequest{
+		ProjectId:  projectID,
+		Starred:    starred,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"starred"}},
+	})
+	return err
+}
+
 // DeleteProject deletes the given project.
 func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -120,3 +290,593 @@ func (c *Client) DeleteProject(ctx context.Context, projectID string) error {
 	})
 	return err
 }
+
+// ListDeletedProjects returns the list of deleted projects that can be
+// restored.
+func (c *Client) ListDeletedProjects(ctx context.Context) ([]*pb.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListDeletedProjects(ctx, &pb.ListDeletedProjectsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Projects, nil
+}
+
+// RestoreProject restores the given deleted project.
+func (c *Client) RestoreProject(ctx context.Context, projectID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.RestoreProject(ctx, &pb.RestoreProjectRequest{
+		ProjectId: projectID,
+	})
+	return err
+}
+
+// GetProjectContents returns the contents of the given project.
+func (c *Client) GetProjectContents(ctx context.Context, projectID string) (*types.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.GetProjectContents(ctx, &pb.GetProjectContentsRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	project := &types.Project{}
+	if err := json.Unmarshal([]byte(res.Contents), project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// DuplicateProject creates a new project of the given name copied from the
+// given project. If name is empty, the name of the source project is used with
+// a suffix.
+func (c *Client) DuplicateProject(ctx context.Context, projectID, name string) (*pb.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.DuplicateProject(ctx, &pb.DuplicateProjectRequest{
+		ProjectId:   projectID,
+		ProjectName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Project, nil
+}
+
+// TransferProject changes the owner of the given project to the given user.
+func (c *Client) TransferProject(ctx context.Context, projectID, newOwner string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.TransferProject(ctx, &pb.TransferProjectRequest{
+		ProjectId: projectID,
+		NewOwner:  newOwner,
+	})
+	return err
+}
+
+// AddCollaborator adds the given user to the collaborators of the project with
+// the given role, "editor" or "viewer".
+func (c *Client) AddCollaborator(ctx context.Context, projectID, userID, role string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.AddCollaborator(ctx, &pb.AddCollaboratorRequest{
+		ProjectId: projectID,
+		UserId:    userID,
+		Role:      role,
+	})
+	return err
+}
+
+// RemoveCollaborator removes the given user from the collaborators of the
+// project.
+func (c *Client) RemoveCollaborator(ctx context.Context, projectID, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.RemoveCollaborator(ctx, &pb.RemoveCollaboratorRequest{
+		ProjectId: projectID,
+		UserId:    userID,
+	})
+	return err
+}
+
+// ListCollaborators returns the owner and the collaborators of the project.
+func (c *Client) ListCollaborators(ctx context.Context, projectID string) ([]*pb.Collaborator, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListCollaborators(ctx, &pb.ListCollaboratorsRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Collaborators, nil
+}
+
+// CreateOrganization creates a new organization of the given name.
+func (c *Client) CreateOrganization(ctx context.Context, name string) (*pb.Organization, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.CreateOrganization(ctx, &pb.CreateOrganizationRequest{
+		OrganizationName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Organization, nil
+}
+
+// ListOrganizations returns the organizations that the user is a member of.
+func (c *Client) ListOrganizations(ctx context.Context) ([]*pb.Organization, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListOrganizations(ctx, &pb.ListOrganizationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Organizations, nil
+}
+
+// AddOrganizationMember adds the given user to the members of the organization
+// with the given role, "admin" or "member".
+func (c *Client) AddOrganizationMember(ctx context.Context, organizationID, userID, role string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.AddOrganizationMember(ctx, &pb.AddOrganizationMemberRequest{
+		OrganizationId: organizationID,
+		UserId:         userID,
+		Role:           role,
+	})
+	return err
+}
+
+// RemoveOrganizationMember removes the given user from the members of the
+// organization.
+func (c *Client) RemoveOrganizationMember(ctx context.Context, organizationID, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.RemoveOrganizationMember(ctx, &pb.RemoveOrganizationMemberRequest{
+		OrganizationId: organizationID,
+		UserId:         userID,
+	})
+	return err
+}
+
+// CreateInvite creates a new invite to the given project with the given role,
+// "editor" or "viewer". If expiresAt is zero, the invite expires in 7 days. If
+// maxUses is 0, the invite can be used any number of times.
+func (c *Client) CreateInvite(
+	ctx context.Context,
+	projectID string,
+	role string,
+	maxUses int,
+	expiresAt time.Time,
+) (*pb.Invite, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req := &pb.CreateInviteRequest{
+		ProjectId: projectID,
+		Role:      role,
+		MaxUses:   int32(maxUses),
+	}
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	res, err := c.client.CreateInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Invite, nil
+}
+
+// AcceptInvite joins the project of the given invite token.
+func (c *Client) AcceptInvite(ctx context.Context, token string) (*pb.Project, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.AcceptInvite(ctx, &pb.AcceptInviteRequest{
+		Token: token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Project, nil
+}
+
+// RevokeInvite revokes the given invite.
+func (c *Client) RevokeInvite(ctx context.Context, inviteID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.RevokeInvite(ctx, &pb.RevokeInviteRequest{
+		InviteId: inviteID,
+	})
+	return err
+}
+
+// ListInvites returns the invites to the given project.
+func (c *Client) ListInvites(ctx context.Context, projectID string) ([]*pb.Invite, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListInvites(ctx, &pb.ListInvitesRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Invites, nil
+}
+
+// CreateAPIKey creates a new API key of the given scope, "read" or
+// "read_write". The key itself is returned only once. If expiresAt is zero,
+// the key never expires.
+func (c *Client) CreateAPIKey(
+	ctx context.Context,
+	name string,
+	scope string,
+	expiresAt time.Time,
+) (*pb.APIKey, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req := &pb.CreateAPIKeyRequest{
+		Name:  name,
+		Scope: scope,
+	}
+	if !expiresAt.IsZero() {
+		req.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	res, err := c.client.CreateAPIKey(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.ApiKey, res.Key, nil
+}
+
+// ListAPIKeys returns the API keys of the user.
+func (c *Client) ListAPIKeys(ctx context.Context) ([]*pb.APIKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListAPIKeys(ctx, &pb.ListAPIKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ApiKeys, nil
+}
+
+// RevokeAPIKey revokes the given API key.
+func (c *Client) RevokeAPIKey(ctx context.Context, apiKeyID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{
+		ApiKeyId: apiKeyID,
+	})
+	return err
+}
+
+// CreateTemplate creates a new template of the given name and contents.
+func (c *Client) CreateTemplate(
+	ctx context.Context,
+	name string,
+	contents string,
+	public bool,
+) (*pb.Template, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.CreateTemplate(ctx, &pb.CreateTemplateRequest{
+		TemplateName: name,
+		Contents:     contents,
+		Public:       public,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Template, nil
+}
+
+// ListTemplates returns the list of templates.
+func (c *Client) ListTemplates(ctx context.Context) ([]*pb.Template, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListTemplates(ctx, &pb.ListTemplatesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Templates, nil
+}
+
+// GetTemplate returns the given template.
+func (c *Client) GetTemplate(ctx context.Context, templateID string) (*pb.Template, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.GetTemplate(ctx, &pb.GetTemplateRequest{
+		TemplateId: templateID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Template, nil
+}
+
+// UpdateTemplate updates the given template.
+func (c *Client) UpdateTemplate(
+	ctx context.Context,
+	templateID string,
+	name string,
+	contents string,
+	public bool,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.UpdateTemplate(ctx, &pb.UpdateTemplateRequest{
+		TemplateId:   templateID,
+		TemplateName: name,
+		Contents:     contents,
+		Public:       public,
+	})
+	return err
+}
+
+// DeleteTemplate deletes the given template.
+func (c *Client) DeleteTemplate(ctx context.Context, templateID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.client.DeleteTemplate(ctx, &pb.DeleteTemplateRequest{
+		TemplateId: templateID,
+	})
+	return err
+}
+
+// ListBuiltinTemplates returns the list of built-in templates.
+func (c *Client) ListBuiltinTemplates(ctx context.Context) ([]*pb.Template, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListBuiltinTemplates(ctx, &pb.ListBuiltinTemplatesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Templates, nil
+}
+
+// SaveProjectAsTemplate creates a new template from the contents of the given
+// project.
+func (c *Client) SaveProjectAsTemplate(
+	ctx context.Context,
+	projectID string,
+	templateName string,
+	public bool,
+	stripPositions bool,
+) (*pb.Template, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.SaveProjectAsTemplate(ctx, &pb.SaveProjectAsTemplateRequest{
+		ProjectId:      projectID,
+		TemplateName:   templateName,
+		Public:         public,
+		StripPositions: stripPositions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Template, nil
+}
+
+// GenerateCode generates PyTorch source code of the given project.
+func (c *Client) GenerateCode(ctx context.Context, projectID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.GenerateCode(ctx, &pb.GenerateCodeRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.Code, nil
+}
+
+// ValidateProject checks the contents of the given project and returns whether
+// it is valid and the diagnostics found.
+func (c *Client) ValidateProject(ctx context.Context, projectID string) (bool, []*pb.Diagnostic, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ValidateProject(ctx, &pb.ValidateProjectRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	return res.Valid, res.Diagnostics, nil
+}
+
+// InferShapes infers the output shape of every block in the given project.
+func (c *Client) InferShapes(ctx context.Context, projectID string) ([]*pb.BlockShape, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.InferShapes(ctx, &pb.InferShapesRequest{
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Shapes, nil
+}
+
+// AnalyzeProject counts the parameters and estimates the multiply-accumulate
+// operations of the given project.
+func (c *Client) AnalyzeProject(ctx context.Context, projectID string) (*pb.AnalyzeProjectResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return c.client.AnalyzeProject(ctx, &pb.AnalyzeProjectRequest{
+		ProjectId: projectID,
+	})
+}
+
+// ListBlockTypes returns the specs of all block types.
+func (c *Client) ListBlockTypes(ctx context.Context) ([]*pb.BlockTypeSpec, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.client.ListBlockTypes(ctx, &pb.ListBlockTypesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.BlockTypes, nil
+}
+
+// AdminListProjects returns the projects of all users that match the given
+// filter. Empty arguments are not used to filter, and name matches the
+// substring of project names. All pages are listed. Only admins can call this
+// method.
+func (c *Client) AdminListProjects(
+	ctx context.Context,
+	owner string,
+	status string,
+	name string,
+) ([]*pb.Project, error) {
+	var projects []*pb.Project
+	pageToken := ""
+	for {
+		page, nextPageToken, err := c.AdminListProjectsPage(ctx, owner, status, name, 0, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, page...)
+		if nextPageToken == "" {
+			return projects, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// AdminListProjectsPage returns the page of projects of all users of the given
+// token and the token of the next page. The first page is returned if
+// pageToken is empty, and the returned token is empty if there is no next
+// page. Only admins can call this method.
+func (c *Client) AdminListProjectsPage(
+	ctx context.Context,
+	owner string,
+	status string,
+	name string,
+	pageSize int,
+	pageToken string,
+) ([]*pb.Project, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := c.adminClient.ListProjects(ctx, &pb.AdminListProjectsRequest{
+		Owner:     owner,
+		Status:    status,
+		Name:      name,
+		PageSize:  int32(pageSize),
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return res.Projects, res.NextPageToken, nil
+}
+
+// AdminListUserProjects returns the projects owned by the given user including
+// deleted ones. Only admins can call this method.
+func (c *Client) AdminListUserProjects(ctx context.Context, userID string) ([]*pb.Project, error) {
+	return c.AdminListProjects(ctx, userID, "", "")
+}
+
+// AdminDeleteProject deletes the given project regardless of its owner. Only
+// admins can call this method.
+func (c *Client) AdminDeleteProject(ctx context.Context, projectID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.adminClient.DeleteProject(ctx, &pb.AdminDeleteProjectRequest{
+		ProjectId: projectID,
+	})
+	return err
+}
+
+// AdminRestoreProject restores the given deleted project regardless of its
+// owner. Only admins can call this method.
+func (c *Client) AdminRestoreProject(ctx context.Context, projectID string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.adminClient.RestoreProject(ctx, &pb.AdminRestoreProjectRequest{
+		ProjectId: projectID,
+	})
+	return err
+}
+
+// AdminTransferProject changes the owner of the given project. Only admins
+// can call this method.
+func (c *Client) AdminTransferProject(ctx context.Context, projectID, newOwner string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.adminClient.TransferProject(ctx, &pb.AdminTransferProjectRequest{
+		ProjectId: projectID,
+		NewOwner:  newOwner,
+	})
+	return err
+}
+
+// GetSystemStats returns the statistics of the server such as the number of
+// projects of each status. Only admins can call this method.
+func (c *Client) GetSystemStats(ctx context.Context) (*pb.GetSystemStatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return c.adminClient.GetSystemStats(ctx, &pb.GetSystemStatsRequest{})
+}
@@ -23,9 +23,8 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-// TODO(youngteac.hong): We need to change below userID with accessToken.
 func unaryInterceptor(
-	userID string,
+	token string,
 ) func(
 	ctx context.Context,
 	method string,
@@ -42,10 +41,10 @@ func unaryInterceptor(
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		return invoker(attachToken(ctx, userID), method, req, reply, cc, opts...)
+		return invoker(attachToken(ctx, token), method, req, reply, cc, opts...)
 	}
 }
 
-func attachToken(ctx context.Context, userID string) context.Context {
-	return metadata.AppendToOutgoingContext(ctx, "authorization", userID)
+func attachToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", token)
 }
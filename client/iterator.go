@@ -0,0 +1,90 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/metis-labs/metis-server/api"
+)
+
+// Done is returned by iterators when there are no more items.
+var Done = errors.New("no more items in iterator")
+
+// ListProjectsOptions is the options of listing projects.
+type ListProjectsOptions struct {
+	// Scope is "all", "personal" or "org:<organization id>".
+	Scope string
+
+	// PageSize is the number of projects requested at a time.
+	PageSize int
+
+	// SortBy is "name", "created_at" or "updated_at".
+	SortBy     string
+	Descending bool
+
+	// NameContains filters projects by the substring of their names.
+	NameContains string
+
+	// Tag filters projects that have the given tag.
+	Tag string
+
+	// Starred filters projects that the user starred.
+	Starred bool
+}
+
+// ProjectIterator walks through all pages of the list of projects.
+type ProjectIterator struct {
+	client *Client
+	opts   ListProjectsOptions
+
+	page          []*pb.Project
+	nextPageToken string
+	fetched       bool
+}
+
+// IterateProjects returns an iterator over the projects listed with the given
+// options.
+func (c *Client) IterateProjects(opts ListProjectsOptions) *ProjectIterator {
+	return &ProjectIterator{
+		client: c,
+		opts:   opts,
+	}
+}
+
+// Next returns the next project. It requests the next page when the current
+// page is exhausted, and returns Done when there are no more projects.
+func (it *ProjectIterator) Next(ctx context.Context) (*pb.Project, error) {
+	for len(it.page) == 0 {
+		if it.fetched && it.nextPageToken == "" {
+			return nil, Done
+		}
+
+		page, nextPageToken, err := it.client.ListProjectsPage(ctx, it.opts, it.nextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		it.page = page
+		it.nextPageToken = nextPageToken
+		it.fetched = true
+	}
+
+	project := it.page[0]
+	it.page = it.page[1:]
+	return project, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/metis-labs/metis-server/internal/log"
+	"github.com/metis-labs/metis-server/server"
+	"github.com/metis-labs/metis-server/server/database/mongodb"
+	"github.com/metis-labs/metis-server/server/purge"
+)
+
+var dryRun bool
+
+func newPurgeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently removes the projects deleted before the retention period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			db := mongodb.NewClient(conf.Mongo)
+			if err := db.Dial(ctx); err != nil {
+				return err
+			}
+			defer func() {
+				if err := db.Close(ctx); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			purged, err := purge.Purge(ctx, db, conf.Yorkie, conf.Purge.RetentionPeriod, dryRun)
+			if err != nil {
+				return err
+			}
+
+			for _, info := range purged {
+				fmt.Printf(
+					"%s\t%s\t%s\t%s\n",
+					info.ID, info.Owner, info.DeletedAt.Format(time.RFC3339), info.Name,
+				)
+			}
+			if dryRun {
+				fmt.Printf("%d projects would be removed\n", len(purged))
+			} else {
+				fmt.Printf("%d projects removed\n", len(purged))
+			}
+
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newPurgeCmd()
+	cmd.Flags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"Print the projects to be removed without removing them",
+	)
+	cmd.Flags().DurationVar(
+		&conf.Purge.RetentionPeriod,
+		"retention-period",
+		server.DefaultPurgeRetentionPeriod,
+		"Period to keep deleted projects before purging them",
+	)
+
+	cmd.Flags().StringVar(
+		&conf.Yorkie.RPCAddr,
+		"yorkie-rpc-addr",
+		server.DefaultYorkieRPCAddr,
+		"Yorkie's RPC Address",
+	)
+	cmd.Flags().StringVar(
+		&conf.Mongo.ConnectionURI,
+		"mongo-connection-uri",
+		server.DefaultMongoConnectionURI,
+		"MongoDB's connection URI",
+	)
+	cmd.Flags().StringVar(
+		&conf.Mongo.Database,
+		"mongo-database",
+		server.DefaultMongoDatabase,
+		"Metis database name in MongoDB",
+	)
+
+	rootCmd.AddCommand(cmd)
+}
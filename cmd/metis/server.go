@@ -114,6 +114,25 @@ func init() {
 		server.DefaultRPCPort,
 		"RPC port",
 	)
+	cmd.Flags().StringSliceVar(
+		&conf.RPC.AdminUserIDs,
+		"admin-user-ids",
+		nil,
+		"IDs of the users who can call the admin methods",
+	)
+	cmd.Flags().DurationVar(
+		&conf.RPC.APIKeyLastUsedInterval,
+		"rpc-api-key-last-used-interval",
+		server.DefaultRPCAPIKeyLastUsedInterval,
+		"Minimum interval of recording the time when the same API key is used",
+	)
+
+	cmd.Flags().DurationVar(
+		&conf.Web.EditRecordInterval,
+		"web-edit-record-interval",
+		server.DefaultWebEditRecordInterval,
+		"Minimum interval of recording the edits of the same user on a project",
+	)
 
 	cmd.Flags().StringVar(
 		&conf.Yorkie.RPCAddr,
@@ -122,6 +141,62 @@ func init() {
 		"Yorkie's RPC Address",
 	)
 
+	cmd.Flags().BoolVar(
+		&conf.Auth.InsecureDev,
+		"auth-insecure-dev",
+		false,
+		"Accept the authorization token as the user ID without verification (development only)",
+	)
+	cmd.Flags().StringVar(
+		&conf.Auth.HMACSecret,
+		"auth-hmac-secret",
+		"",
+		"Secret to verify HS256 tokens",
+	)
+	cmd.Flags().StringVar(
+		&conf.Auth.RSAPublicKeyFile,
+		"auth-rsa-public-key-file",
+		"",
+		"PEM file of the public key to verify RS256 tokens",
+	)
+	cmd.Flags().StringVar(
+		&conf.Auth.JWKSFile,
+		"auth-jwks-file",
+		"",
+		"JSON Web Key Set file of the public keys to verify RS256 tokens",
+	)
+	cmd.Flags().StringVar(
+		&conf.Auth.UserIDClaim,
+		"auth-user-id-claim",
+		server.DefaultAuthUserIDClaim,
+		"Claim of the token that holds the user ID",
+	)
+	cmd.Flags().StringVar(
+		&conf.Auth.Issuer,
+		"auth-issuer",
+		"",
+		"Expected issuer of tokens",
+	)
+	cmd.Flags().StringVar(
+		&conf.Auth.Audience,
+		"auth-audience",
+		"",
+		"Expected audience of tokens",
+	)
+
+	cmd.Flags().DurationVar(
+		&conf.Purge.RetentionPeriod,
+		"purge-retention-period",
+		server.DefaultPurgeRetentionPeriod,
+		"Period to keep deleted projects before purging them",
+	)
+	cmd.Flags().DurationVar(
+		&conf.Purge.Interval,
+		"purge-interval",
+		server.DefaultPurgeInterval,
+		"Interval of purging deleted projects (0 disables purging)",
+	)
+
 	cmd.Flags().StringVar(
 		&conf.Mongo.ConnectionURI,
 		"mongo-connection-uri",
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package testutil provides the fixtures shared by the tests of the packages
+// of Metis.
+package testutil
+
+import (
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+// Fixture is a project with a single network for tests.
+type Fixture struct {
+	Project *types.Project
+	Network *types.Network
+	In      *types.Block
+	Out     *types.Block
+}
+
+// NewFixture creates a new project of the given name with the default network,
+// which has the in and out blocks without links.
+func NewFixture(name string) *Fixture {
+	f := &Fixture{Project: types.NewProject("", name)}
+	for _, network := range f.Project.Networks {
+		f.Network = network
+	}
+	for _, block := range f.Network.Blocks {
+		if block.Type == types.InType {
+			f.In = block
+		} else {
+			f.Out = block
+		}
+	}
+
+	return f
+}
+
+// Add adds the given blocks to the network.
+func (f *Fixture) Add(blocks ...*types.Block) {
+	for _, block := range blocks {
+		f.Network.Blocks[block.ID] = block
+	}
+}
+
+// Link links the blocks of the given IDs. The blocks do not have to exist in
+// the network.
+func (f *Fixture) Link(from, to string) {
+	link := types.NewLink(from, to)
+	f.Network.Links[link.ID] = link
+}
+
+// Chain adds the given blocks to the network and links them in order.
+func (f *Fixture) Chain(blocks ...*types.Block) {
+	f.Add(blocks...)
+	for i := 1; i < len(blocks); i++ {
+		f.Link(blocks[i-1].ID, blocks[i].ID)
+	}
+}
@@ -0,0 +1,270 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/metis-labs/metis-server/server/shapes"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+// ErrUnknownShape is returned when the multiply-accumulate operations of the
+// block cannot be estimated because the shape of its input is not known.
+var ErrUnknownShape = errors.New("unknown shape")
+
+// BlockStats is the number of parameters and multiply-accumulate operations of
+// a block, including its Repeats. Err is the reason why MACs could not be
+// estimated.
+type BlockStats struct {
+	NetworkID string
+	BlockID   string
+	Params    int64
+	MACs      int64
+	Err       error
+}
+
+// NetworkStats is the number of parameters and multiply-accumulate operations
+// of a network.
+type NetworkStats struct {
+	NetworkID string
+	Params    int64
+	MACs      int64
+}
+
+// Report is the result of the analysis of a project. The totals of the project
+// are the sums of the networks that are not referenced by other networks.
+type Report struct {
+	Params   int64
+	MACs     int64
+	Networks []*NetworkStats
+	Blocks   []*BlockStats
+}
+
+// Analyze counts the parameters and estimates the multiply-accumulate
+// operations(MACs) of the given project. MACs are estimated per sample, so the
+// batch dimension is not counted. Input shapes are inferred by the shapes
+// package, and a network referenced by several network blocks is counted with
+// the shapes of the first one.
+func Analyze(project *types.Project) (*Report, error) {
+	networks, err := project.SortedNetworks()
+	if err != nil {
+		return nil, err
+	}
+
+	blockShapes, err := shapes.Infer(project)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]shapes.Shape)
+	for _, blockShape := range blockShapes {
+		if blockShape.Err == nil {
+			outputs[blockShape.NetworkID+"/"+blockShape.BlockID] = blockShape.Shape
+		}
+	}
+
+	report := &Report{}
+	networkStats := make(map[string]*NetworkStats)
+	referenced := make(map[string]bool)
+	for _, n := range networks {
+		blocks, err := n.SortedBlocks()
+		if err != nil {
+			return nil, err
+		}
+
+		stats := &NetworkStats{NetworkID: n.ID}
+		for _, b := range blocks {
+			if b.Type == types.InType || b.Type == types.OutType {
+				continue
+			}
+
+			var blockStats *BlockStats
+			if b.Type == types.NetworkType {
+				referenced[b.RefNetwork] = true
+				blockStats = networkBlock(b, networkStats[b.RefNetwork])
+			} else {
+				var in shapes.Shape
+				if inputs := n.Inputs(b.ID); len(inputs) > 0 {
+					in = outputs[n.ID+"/"+inputs[0].ID]
+				}
+				blockStats = layer(b, in)
+			}
+			blockStats.NetworkID = n.ID
+			blockStats.BlockID = b.ID
+
+			stats.Params += blockStats.Params
+			stats.MACs += blockStats.MACs
+			report.Blocks = append(report.Blocks, blockStats)
+		}
+
+		networkStats[n.ID] = stats
+		report.Networks = append(report.Networks, stats)
+	}
+
+	for _, stats := range report.Networks {
+		if !referenced[stats.NetworkID] {
+			report.Params += stats.Params
+			report.MACs += stats.MACs
+		}
+	}
+
+	return report, nil
+}
+
+// networkBlock returns the stats of the network block from the stats of the
+// network it refers to.
+func networkBlock(b *types.Block, ref *NetworkStats) *BlockStats {
+	if ref == nil {
+		return &BlockStats{Err: fmt.Errorf("missing network %q: %w", b.RefNetwork, ErrUnknownShape)}
+	}
+
+	repeats := int64(repeatsOf(b))
+	return &BlockStats{
+		Params: ref.Params * repeats,
+		MACs:   ref.MACs * repeats,
+	}
+}
+
+// layer returns the stats of the normal block applied Repeats times to the
+// given input shape. If the input shape is nil, only parameters are counted.
+func layer(b *types.Block, in shapes.Shape) *BlockStats {
+	stats := &BlockStats{}
+	if in == nil {
+		stats.Err = ErrUnknownShape
+	}
+
+	for i := 0; i < repeatsOf(b); i++ {
+		params, err := paramsOf(b)
+		if err != nil {
+			return &BlockStats{Err: err}
+		}
+		stats.Params += params
+
+		if stats.Err != nil {
+			continue
+		}
+		out, err := shapes.Layer(b, in)
+		if err != nil {
+			stats.MACs, stats.Err = 0, err
+			continue
+		}
+		macs, err := macsOf(b, in, out)
+		if err != nil {
+			stats.MACs, stats.Err = 0, err
+			continue
+		}
+		stats.MACs += macs
+		in = out
+	}
+
+	return stats
+}
+
+// paramsOf returns the number of learnable parameters of a single application
+// of the given block.
+func paramsOf(b *types.Block) (int64, error) {
+	switch b.Type.Canonical() {
+	case types.Conv2dType:
+		in, out, kernel, groups, err := conv2d(b)
+		if err != nil {
+			return 0, err
+		}
+		params := out * (in / groups) * kernel * kernel
+		bias, err := b.BoolParameter("bias")
+		if err != nil {
+			return 0, err
+		}
+		if bias {
+			params += out
+		}
+		return params, nil
+	case types.BatchNorm2dType:
+		affine, err := b.BoolParameter("affine")
+		if err != nil || !affine {
+			return 0, err
+		}
+		features, err := b.IntParameter("num_features")
+		if err != nil {
+			return 0, err
+		}
+		// Running mean and variance are buffers, not parameters.
+		return 2 * int64(features), nil
+	}
+
+	return 0, nil
+}
+
+// macsOf returns the multiply-accumulate operations of a single application
+// of the given block. Batch normalization is counted as one operation per
+// output element, and activations and pooling are not counted.
+func macsOf(b *types.Block, in, out shapes.Shape) (int64, error) {
+	switch b.Type.Canonical() {
+	case types.Conv2dType:
+		elements, err := elementsOf(out)
+		if err != nil {
+			return 0, err
+		}
+		channels, _, kernel, groups, err := conv2d(b)
+		if err != nil {
+			return 0, err
+		}
+		return elements * (channels / groups) * kernel * kernel, nil
+	case types.BatchNorm2dType:
+		return elementsOf(out)
+	}
+
+	return 0, nil
+}
+
+// conv2d returns the in channels, out channels, kernel size and groups of the
+// given Conv2d block.
+func conv2d(b *types.Block) (in, out, kernel, groups int64, err error) {
+	var values [4]int
+	for i, name := range []string{"in_channels", "out_channels", "kernel_size", "groups"} {
+		if values[i], err = b.IntParameter(name); err != nil {
+			return
+		}
+	}
+	return int64(values[0]), int64(values[1]), int64(values[2]), int64(values[3]), nil
+}
+
+// elementsOf returns the number of elements of a sample of the given shape.
+// The first dimension of (N, C, H, W) is the batch.
+func elementsOf(shape shapes.Shape) (int64, error) {
+	dims := shape
+	if len(dims) == 4 {
+		dims = dims[1:]
+	}
+
+	elements := int64(1)
+	for _, d := range dims {
+		if d == shapes.Dynamic {
+			return 0, fmt.Errorf("%s: %w", shape, ErrUnknownShape)
+		}
+		elements *= int64(d)
+	}
+
+	return elements, nil
+}
+
+func repeatsOf(b *types.Block) int {
+	if b.Repeats < 1 {
+		return 1
+	}
+	return b.Repeats
+}
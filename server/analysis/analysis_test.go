@@ -0,0 +1,63 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/server/analysis"
+	"github.com/metis-labs/metis-server/server/templates"
+)
+
+func TestAnalysis(t *testing.T) {
+	t.Run("analyze lenet test", func(t *testing.T) {
+		project, err := templates.FindBuiltin("lenet")
+		assert.NoError(t, err)
+
+		report, err := analysis.Analyze(project)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(61706), report.Params)
+		assert.Equal(t, int64(416520), report.MACs)
+	})
+
+	t.Run("analyze network block test", func(t *testing.T) {
+		project, err := templates.FindBuiltin("resnet18-basic-block")
+		assert.NoError(t, err)
+
+		report, err := analysis.Analyze(project)
+		assert.NoError(t, err)
+
+		var basicBlock *analysis.NetworkStats
+		for _, stats := range report.Networks {
+			if project.Networks[stats.NetworkID].Name == "BasicBlock" {
+				basicBlock = stats
+			}
+		}
+		assert.NotNil(t, basicBlock)
+
+		// layer1 repeats BasicBlock twice.
+		for _, stats := range report.Blocks {
+			block := project.Networks[stats.NetworkID].Blocks[stats.BlockID]
+			if block.Name == "layer1" {
+				assert.Equal(t, basicBlock.Params*2, stats.Params)
+				assert.Equal(t, basicBlock.MACs*2, stats.MACs)
+			}
+		}
+	})
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/metis-labs/metis-server/internal/log"
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+const (
+	// keyPrefix is the prefix of API keys to tell them apart from user
+	// tokens.
+	keyPrefix = "mts_"
+
+	keySize = 32
+
+	// displayedSize is the size of the prefix of the key kept to display.
+	displayedSize = len(keyPrefix) + 6
+)
+
+// ErrInvalidAPIKey is returned when the scope or the expiry of the API key is
+// not valid.
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// IsAPIKey returns whether the given token is an API key.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, keyPrefix)
+}
+
+// Create creates a new API key of the user. The key itself is returned only
+// once, and only its hash is stored. If expiresAt is zero, the key never
+// expires.
+func Create(
+	ctx context.Context,
+	db database.Database,
+	name string,
+	scope types.APIKeyScope,
+	expiresAt time.Time,
+) (*types.APIKeyInfo, string, error) {
+	if scope != types.APIKeyRead && scope != types.APIKeyReadWrite {
+		return nil, "", fmt.Errorf("scope %q: %w", scope, ErrInvalidAPIKey)
+	}
+	if !expiresAt.IsZero() && expiresAt.Before(time.Now()) {
+		return nil, "", fmt.Errorf("expires at %s: %w", expiresAt, ErrInvalidAPIKey)
+	}
+
+	bytes := make([]byte, keySize)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, "", err
+	}
+	key := keyPrefix + base64.RawURLEncoding.EncodeToString(bytes)
+
+	info, err := db.CreateAPIKey(ctx, name, key[:displayedSize], hash(key), scope, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return info, key, nil
+}
+
+// Verify returns the API key of the given key if it exists and is not
+// expired, and records the time when it is used. The time is recorded only if
+// the previous record is older than lastUsedInterval, so that every request
+// with the key does not write to the database.
+func Verify(
+	ctx context.Context,
+	db database.Database,
+	key string,
+	lastUsedInterval time.Duration,
+) (*types.APIKeyInfo, error) {
+	info, err := db.FindAPIKeyByHash(ctx, hash(key))
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, fmt.Errorf("unknown api key: %w", auth.ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if info.Expired(now) {
+		return nil, fmt.Errorf("expired api key: %w", auth.ErrUnauthenticated)
+	}
+
+	if info.LastUsedAt.After(now.Add(-lastUsedInterval)) {
+		return info, nil
+	}
+	if err := db.UpdateAPIKeyLastUsed(ctx, info.ID, now); err != nil {
+		log.Logger.Warnf("update last used of api key %s: %s", info.ID, err.Error())
+	}
+
+	return info, nil
+}
+
+// hash returns the hash of the given key to store.
+func hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,348 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+var (
+	// ErrUnsupportedBlockType is returned when code cannot be generated for the
+	// type of block.
+	ErrUnsupportedBlockType = errors.New("unsupported block type")
+
+	// ErrMissingNetwork is returned when a network block references a network
+	// that does not exist in the project.
+	ErrMissingNetwork = errors.New("missing network")
+)
+
+const indent = "    "
+
+// Generate generates PyTorch source code of the given project. Each network
+// of the project becomes a subclass of nn.Module, and networks referenced by
+// network blocks are defined before the networks referencing them.
+func Generate(project *types.Project) (string, error) {
+	networks, err := project.SortedNetworks()
+	if err != nil {
+		return "", err
+	}
+
+	g := &generator{
+		project:    project,
+		classNames: make(map[string]string),
+	}
+
+	classNamer := newNamer(className)
+	for _, n := range networks {
+		g.classNames[n.ID] = classNamer.name(n.Name)
+	}
+
+	var sections []string
+	imports := g.imports(networks)
+	if imports != "" {
+		sections = append(sections, imports)
+	}
+	for _, n := range networks {
+		class, err := g.class(n)
+		if err != nil {
+			return "", err
+		}
+		sections = append(sections, class)
+	}
+
+	return strings.Join(sections, "\n\n"), nil
+}
+
+// generator holds the state used while generating code of a project.
+type generator struct {
+	project    *types.Project
+	classNames map[string]string
+
+	// nn is the name used to refer to the torch.nn module.
+	nn string
+}
+
+// imports returns the import statements of the dependencies of the given
+// networks. Built-in, third party and project dependencies are grouped in this
+// order.
+func (g *generator) imports(networks []*types.Network) string {
+	groups := make([]map[string]bool, 3)
+	for i := range groups {
+		groups[i] = make(map[string]bool)
+	}
+
+	// nnNames maps the import statements of torch.nn to the names they give to
+	// it. If torch.nn is imported more than once, the name of the first
+	// statement in sorted order is used, so that the code does not depend on
+	// the order of the dependency maps.
+	nnNames := make(map[string]string)
+	for _, n := range networks {
+		if n.Dependencies == nil {
+			continue
+		}
+		for i, deps := range []map[string]*types.Dependency{
+			n.Dependencies.BuiltInDeps,
+			n.Dependencies.ThirdPartyDeps,
+			n.Dependencies.ProjectDeps,
+		} {
+			for _, d := range deps {
+				statement := importStatement(d)
+				groups[i][statement] = true
+				if nn, ok := nnName(d); ok {
+					nnNames[statement] = nn
+				}
+			}
+		}
+	}
+
+	var nnStatements []string
+	for statement := range nnNames {
+		nnStatements = append(nnStatements, statement)
+	}
+	sort.Strings(nnStatements)
+	if len(nnStatements) > 0 {
+		g.nn = nnNames[nnStatements[0]]
+	} else {
+		g.nn = "nn"
+		groups[1]["import torch.nn as nn"] = true
+	}
+
+	var blocks []string
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		var lines []string
+		for line := range group {
+			lines = append(lines, line)
+		}
+		sort.Strings(lines)
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+// class returns the definition of the nn.Module class of the given network.
+func (g *generator) class(n *types.Network) (string, error) {
+	blocks, err := n.SortedBlocks()
+	if err != nil {
+		return "", err
+	}
+
+	// Block names are used as both attribute names of the module and variable
+	// names in forward(), so they share a namer.
+	namer := newNamer(identifier)
+	namer.reserve("self")
+	names := make(map[string]string)
+
+	var ins []*types.Block
+	for _, b := range blocks {
+		if b.Type == types.InType {
+			ins = append(ins, b)
+		}
+	}
+	for _, b := range ins {
+		if len(ins) == 1 {
+			names[b.ID] = namer.name("x")
+		} else {
+			names[b.ID] = namer.name(b.Name)
+		}
+	}
+	for _, b := range blocks {
+		if b.Type != types.InType && b.Type != types.OutType {
+			names[b.ID] = namer.name(b.Name)
+		}
+	}
+
+	className := g.classNames[n.ID]
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "class %s(%s.Module):\n", className, g.nn)
+
+	// __init__
+	fmt.Fprintf(w, "%sdef __init__(self):\n", indent)
+	fmt.Fprintf(w, "%s%ssuper(%s, self).__init__()\n", indent, indent, className)
+	for _, b := range blocks {
+		if b.Type == types.InType || b.Type == types.OutType {
+			continue
+		}
+
+		layer, err := g.layer(b)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", n.Name, b.Name, err)
+		}
+		fmt.Fprintf(w, "%s%sself.%s = %s\n", indent, indent, names[b.ID], layer)
+	}
+
+	// forward
+	var args []string
+	for _, b := range ins {
+		args = append(args, names[b.ID])
+	}
+	fmt.Fprintf(w, "\n%sdef forward(%s):\n", indent, strings.Join(append([]string{"self"}, args...), ", "))
+
+	var returns []string
+	reachable := make(map[string]bool)
+	for _, b := range ins {
+		reachable[b.ID] = true
+	}
+	for _, b := range blocks {
+		if b.Type == types.InType {
+			continue
+		}
+
+		var inputs []string
+		for _, in := range n.Inputs(b.ID) {
+			if reachable[in.ID] {
+				inputs = append(inputs, names[in.ID])
+			}
+		}
+
+		if b.Type == types.OutType {
+			if len(inputs) == 0 {
+				returns = append(returns, "None")
+			} else {
+				returns = append(returns, strings.Join(inputs, " + "))
+			}
+			continue
+		}
+
+		// Blocks that cannot be reached from the in blocks are defined in
+		// __init__ but not used in forward().
+		if len(inputs) == 0 {
+			continue
+		}
+		reachable[b.ID] = true
+		fmt.Fprintf(w, "%s%s%s = self.%s(%s)\n", indent, indent, names[b.ID], names[b.ID], strings.Join(inputs, " + "))
+	}
+
+	switch len(returns) {
+	case 0:
+		fmt.Fprintf(w, "%s%sreturn None\n", indent, indent)
+	case 1:
+		fmt.Fprintf(w, "%s%sreturn %s\n", indent, indent, returns[0])
+	default:
+		fmt.Fprintf(w, "%s%sreturn %s\n", indent, indent, strings.Join(returns, ", "))
+	}
+
+	return w.String(), nil
+}
+
+// layer returns the expression that creates the layer of the given block.
+func (g *generator) layer(b *types.Block) (string, error) {
+	var layer string
+	if b.Type == types.NetworkType {
+		ref, ok := g.project.Networks[b.RefNetwork]
+		if !ok {
+			return "", fmt.Errorf("%s: %w", b.RefNetwork, ErrMissingNetwork)
+		}
+		layer = g.classNames[ref.ID] + "()"
+	} else {
+		spec, ok := types.FindBlockSpec(b.Type)
+		if !ok || spec.TorchClass == "" {
+			return "", fmt.Errorf("%s: %w", b.Type, ErrUnsupportedBlockType)
+		}
+		layer = fmt.Sprintf("%s.%s(%s)", g.nn, spec.TorchClass, arguments(spec, b.Parameters))
+	}
+
+	if b.Repeats > 1 {
+		return fmt.Sprintf("%s.Sequential(*[%s for _ in range(%d)])", g.nn, layer, b.Repeats), nil
+	}
+	return layer, nil
+}
+
+// importStatement returns the import statement of the given dependency.
+func importStatement(d *types.Dependency) string {
+	var statement string
+	if d.Package != "" {
+		statement = fmt.Sprintf("from %s import %s", d.Package, d.Name)
+	} else {
+		statement = fmt.Sprintf("import %s", d.Name)
+	}
+
+	if d.Alias != "" {
+		statement += " as " + d.Alias
+	}
+	return statement
+}
+
+// nnName returns the name that refers to torch.nn if the given dependency
+// imports it.
+func nnName(d *types.Dependency) (string, bool) {
+	isNN := (d.Package == "" && d.Name == "torch.nn") || (d.Package == "torch" && d.Name == "nn")
+	if !isNN {
+		return "", false
+	}
+
+	if d.Alias != "" {
+		return d.Alias, true
+	}
+	return d.Name, true
+}
+
+// arguments returns the keyword arguments of the given parameters ordered by
+// name. Values are converted to the types declared in the given spec if
+// possible.
+func arguments(spec *types.BlockSpec, params types.Parameters) string {
+	var keys []string
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var args []string
+	for _, k := range keys {
+		v := params[k]
+		if param, ok := spec.Parameter(k); ok {
+			if coerced, err := param.Coerce(v); err == nil {
+				v = coerced
+			}
+		}
+		args = append(args, fmt.Sprintf("%s=%s", k, literal(v)))
+	}
+	return strings.Join(args, ", ")
+}
+
+// literal returns the Python literal of the given parameter value.
+func literal(v types.ParameterValue) string {
+	switch v := v.(type) {
+	case nil:
+		return "None"
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".eIN") {
+			s += ".0"
+		}
+		return s
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
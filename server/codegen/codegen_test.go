@@ -0,0 +1,73 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/internal/testutil"
+	"github.com/metis-labs/metis-server/server/codegen"
+	"github.com/metis-labs/metis-server/server/templates"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestCodegen(t *testing.T) {
+	t.Run("generate code test", func(t *testing.T) {
+		project, err := templates.FindBuiltin("resnet18-basic-block")
+		assert.NoError(t, err)
+
+		code, err := codegen.Generate(project)
+		assert.NoError(t, err)
+		assert.Contains(t, code, "import torch.nn as nn")
+		assert.Contains(t, code, "class BasicBlock(nn.Module):")
+		assert.Contains(t, code, "self.layer1 = nn.Sequential(*[BasicBlock() for _ in range(2)])")
+		assert.Contains(t, code, "relu2 = self.relu2(bn2 + x)")
+	})
+
+	t.Run("torch.nn alias test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+		relu := types.NewBlock(types.ReLUType, "relu")
+		f.Chain(f.In, relu, f.Out)
+		alias := &types.Dependency{ID: "alias", Name: "nn", Alias: "tnn", Package: "torch"}
+		f.Network.Dependencies.ThirdPartyDeps[alias.ID] = alias
+
+		// torch.nn is imported twice, and the first import in sorted order is
+		// used regardless of the order of the dependencies.
+		code, err := codegen.Generate(f.Project)
+		assert.NoError(t, err)
+		assert.Contains(t, code, "(tnn.Module):")
+		assert.Contains(t, code, "self.relu = tnn.ReLU(")
+		for i := 0; i < 20; i++ {
+			other, err := codegen.Generate(f.Project)
+			assert.NoError(t, err)
+			assert.Equal(t, code, other)
+		}
+	})
+
+	t.Run("recursive network test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+		block := types.NewBlock(types.NetworkType, "self")
+		block.RefNetwork = f.Network.ID
+		f.Add(block)
+
+		_, err := codegen.Generate(f.Project)
+		assert.True(t, errors.Is(err, types.ErrRecursiveNetwork))
+	})
+}
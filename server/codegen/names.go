@@ -0,0 +1,117 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// keywords is the list of Python keywords that cannot be used as identifiers.
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true,
+	"assert": true, "async": true, "await": true, "break": true, "class": true,
+	"continue": true, "def": true, "del": true, "elif": true, "else": true,
+	"except": true, "finally": true, "for": true, "from": true, "global": true,
+	"if": true, "import": true, "in": true, "is": true, "lambda": true,
+	"nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// namer issues unique Python identifiers.
+type namer struct {
+	convert func(string) string
+	used    map[string]bool
+}
+
+func newNamer(convert func(string) string) *namer {
+	return &namer{
+		convert: convert,
+		used:    make(map[string]bool),
+	}
+}
+
+// reserve marks the given identifier as used.
+func (n *namer) reserve(name string) {
+	n.used[name] = true
+}
+
+// name returns a unique identifier converted from the given name.
+func (n *namer) name(name string) string {
+	base := n.convert(name)
+	candidate := base
+	for i := 2; n.used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", base, i)
+	}
+	n.used[candidate] = true
+
+	return candidate
+}
+
+// identifier converts the given name to a Python identifier in snake case.
+func identifier(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+
+	id := strings.Trim(b.String(), "_")
+	if id == "" {
+		return "block"
+	}
+	if unicode.IsDigit(rune(id[0])) {
+		id = "block_" + id
+	}
+	if keywords[id] {
+		id += "_"
+	}
+	return id
+}
+
+// className converts the given name to a Python class name in camel case.
+func className(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r >= unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	class := b.String()
+	if class == "" {
+		return "Network"
+	}
+	if unicode.IsDigit(rune(class[0])) {
+		class = "Network" + class
+	}
+	if keywords[class] {
+		class += "_"
+	}
+	return class
+}
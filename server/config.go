@@ -18,18 +18,23 @@ package server
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/metis-labs/metis-server/server/database/mongodb"
+	"github.com/metis-labs/metis-server/server/purge"
 	"github.com/metis-labs/metis-server/server/rpc"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
 	"github.com/metis-labs/metis-server/server/web"
 	"github.com/metis-labs/metis-server/server/yorkie"
 )
 
 // The following are the defaults for the Server config.
 const (
-	DefaultRPCPort = 10118
+	DefaultRPCPort                   = 10118
+	DefaultRPCAPIKeyLastUsedInterval = time.Minute
 
-	DefaultWebPort = 10119
+	DefaultWebPort               = 10119
+	DefaultWebEditRecordInterval = 30 * time.Second
 
 	DefaultMongoConnectionURI        = "mongodb://localhost:27017"
 	DefaultMongoConnectionTimeoutSec = 5
@@ -39,6 +44,11 @@ const (
 	DefaultYorkieRPCAddr      = "localhost:11101"
 	DefaultYorkieWebhookToken = "metis-server"
 	DefaultYorkieCollection   = "projects"
+
+	DefaultAuthUserIDClaim = auth.DefaultUserIDClaim
+
+	DefaultPurgeRetentionPeriod = 30 * 24 * time.Hour
+	DefaultPurgeInterval        = time.Hour
 )
 
 // Config is the configuration for creating a Server instance.
@@ -47,6 +57,8 @@ type Config struct {
 	Web    *web.Config     `json:"Web"`
 	Mongo  *mongodb.Config `json:"Mongo"`
 	Yorkie *yorkie.Config  `json:"Yorkie"`
+	Auth   *auth.Config    `json:"Auth"`
+	Purge  *purge.Config   `json:"Purge"`
 }
 
 // RPCAddr returns the RPC address.
@@ -54,15 +66,22 @@ func (c Config) RPCAddr() string {
 	return fmt.Sprintf("localhost:%d", c.RPC.Port)
 }
 
+// WebAddr returns the address of the web server.
+func (c Config) WebAddr() string {
+	return fmt.Sprintf("localhost:%d", c.Web.Port)
+}
+
 // NewConfig returns a Config struct that contains reasonable defaults
 // for most of the configurations.
 func NewConfig() *Config {
 	return &Config{
 		RPC: &rpc.Config{
-			Port: DefaultRPCPort,
+			Port:                   DefaultRPCPort,
+			APIKeyLastUsedInterval: DefaultRPCAPIKeyLastUsedInterval,
 		},
 		Web: &web.Config{
-			Port: DefaultWebPort,
+			Port:               DefaultWebPort,
+			EditRecordInterval: DefaultWebEditRecordInterval,
 		},
 		Mongo: &mongodb.Config{
 			ConnectionURI:        DefaultMongoConnectionURI,
@@ -75,5 +94,12 @@ func NewConfig() *Config {
 			WebhookToken: DefaultYorkieWebhookToken,
 			Collection:   DefaultYorkieCollection,
 		},
+		Auth: &auth.Config{
+			UserIDClaim: DefaultAuthUserIDClaim,
+		},
+		Purge: &purge.Config{
+			RetentionPeriod: DefaultPurgeRetentionPeriod,
+			Interval:        DefaultPurgeInterval,
+		},
 	}
 }
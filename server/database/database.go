@@ -19,6 +19,7 @@ package database
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/metis-labs/metis-server/server/types"
 )
@@ -29,19 +30,189 @@ var (
 
 	// ErrNotFound is returned when the requested resource cannot be found.
 	ErrNotFound = errors.New("resource not found")
+
+	// ErrInvalidScope is returned when the given scope of listing projects is
+	// unknown.
+	ErrInvalidScope = errors.New("invalid scope")
+
+	// ErrInvalidPageToken is returned when the given page token cannot be
+	// decoded or does not match the order of listing.
+	ErrInvalidPageToken = errors.New("invalid page token")
+
+	// ErrInvalidSortBy is returned when the given field to sort projects is
+	// unknown.
+	ErrInvalidSortBy = errors.New("invalid sort by")
+
+	// ErrInvalidOwner is returned when the new owner of a project is empty.
+	ErrInvalidOwner = errors.New("invalid owner")
+)
+
+// Belows are the scopes of listing projects.
+const (
+	// ScopeAll lists all projects that the user can access.
+	ScopeAll = "all"
+
+	// ScopePersonal lists the projects that do not belong to organizations.
+	ScopePersonal = "personal"
+
+	// ScopeOrganizationPrefix is followed by the ID of the organization to list
+	// the projects of the organization.
+	ScopeOrganizationPrefix = "org:"
+)
+
+// Belows are the fields to sort projects by.
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
 )
 
+// Belows are the sizes of the pages of listing projects.
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// ListProjectsOptions is the options of listing projects.
+type ListProjectsOptions struct {
+	// Scope is ScopeAll, ScopePersonal or ScopeOrganizationPrefix followed by
+	// the ID of the organization. If it is empty, ScopeAll is used.
+	Scope string
+
+	// PageSize is the maximum number of projects in a page. If it is zero,
+	// DefaultPageSize is used, and it is capped at MaxPageSize.
+	PageSize int
+
+	// PageToken is the token returned with the previous page to list the next
+	// page. If it is empty, the first page is listed.
+	PageToken string
+
+	// SortBy is SortByName, SortByCreatedAt or SortByUpdatedAt. If it is
+	// empty, SortByCreatedAt is used.
+	SortBy string
+
+	// Descending sorts projects in descending order.
+	Descending bool
+
+	// NameContains filters projects by the substring of their names. The
+	// match is case-insensitive.
+	NameContains string
+
+	// Tag filters projects that have the given tag.
+	Tag string
+
+	// Starred filters projects that the user starred.
+	Starred bool
+}
+
+// ProjectUpdate is the update of a project. Nil fields are left unchanged.
+type ProjectUpdate struct {
+	Name        *string
+	Description *string
+	Tags        *[]string
+
+	// Starred stars or unstars the project for the user. Unlike the other
+	// fields, it only requires the user to be able to read the project.
+	Starred *bool
+}
+
+// IsStarOnly returns whether the update only stars or unstars the project.
+func (u *ProjectUpdate) IsStarOnly() bool {
+	return u.Name == nil && u.Description == nil && u.Tags == nil && u.Starred != nil
+}
+
+// ProjectFilter is the filter of listing the projects of all users. Empty
+// fields are not used to filter, and the projects are listed page by page.
+type ProjectFilter struct {
+	// Owner is the ID of the owner of projects.
+	Owner string
+
+	// Status is the status of projects, "created" or "deleted".
+	Status string
+
+	// Name is the substring of the names of projects.
+	Name string
+
+	// PageSize is the maximum number of projects in a page. If it is zero,
+	// DefaultPageSize is used, and it is capped at MaxPageSize.
+	PageSize int
+
+	// PageToken is the token returned with the previous page to list the next
+	// page. If it is empty, the first page is listed.
+	PageToken string
+}
+
 // Database represents database which reads or saves Metis data.
 type Database interface {
 	Dial(ctx context.Context) error
 	Close(ctx context.Context) error
 
-	CreateProject(ctx context.Context, name string) (*types.ProjectInfo, error)
+	CreateProject(
+		ctx context.Context,
+		name string,
+		organizationID types.ID,
+		forkedFrom types.ID,
+	) (*types.ProjectInfo, error)
 	FindProject(ctx context.Context, id types.ID) (*types.ProjectInfo, error)
-	ListProjects(ctx context.Context) ([]*types.ProjectInfo, error)
-	UpdateProject(ctx context.Context, id types.ID, name string) error
+	ListProjects(ctx context.Context, opts *ListProjectsOptions) ([]*types.ProjectInfo, string, error)
+	UpdateProject(ctx context.Context, id types.ID, update *ProjectUpdate) error
 	DeleteProject(ctx context.Context, id types.ID) error
+	ListDeletedProjects(ctx context.Context) ([]*types.ProjectInfo, error)
+	RestoreProject(ctx context.Context, id types.ID) error
+	TransferProject(ctx context.Context, id types.ID, newOwner string) error
+	RemoveProject(ctx context.Context, id types.ID) error
+
+	// Belows are for the administrators of the server, and do not check that
+	// the user can access the projects.
+	ListAllProjects(ctx context.Context, filter *ProjectFilter) ([]*types.ProjectInfo, string, error)
+	ForceDeleteProject(ctx context.Context, id types.ID) error
+	ForceRestoreProject(ctx context.Context, id types.ID) error
+	ForceTransferProject(ctx context.Context, id types.ID, newOwner string) error
+	CountProjectsByStatus(ctx context.Context) (map[string]int, error)
+	ListProjectsDeletedBefore(ctx context.Context, deletedBefore time.Time) ([]*types.ProjectInfo, error)
+	ClaimProjectForPurge(ctx context.Context, id types.ID, deletedBefore time.Time) error
+	PurgeProject(ctx context.Context, id types.ID) error
+
+	// RecordProjectEdit is called after the access to the document of the
+	// project has been verified, so it does not check it again.
+	RecordProjectEdit(ctx context.Context, id types.ID, editor string, editedAt time.Time, interval time.Duration) error
+
+	AddCollaborator(ctx context.Context, id types.ID, userID string, role types.Role) error
+	RemoveCollaborator(ctx context.Context, id types.ID, userID string) error
+
+	CreateOrganization(ctx context.Context, name string) (*types.OrganizationInfo, error)
+	FindOrganization(ctx context.Context, id types.ID) (*types.OrganizationInfo, error)
+	ListOrganizations(ctx context.Context) ([]*types.OrganizationInfo, error)
+	AddMember(ctx context.Context, id types.ID, userID string, role types.OrganizationRole) error
+	RemoveMember(ctx context.Context, id types.ID, userID string) error
+
+	CreateInvite(
+		ctx context.Context,
+		projectID types.ID,
+		token string,
+		role types.Role,
+		maxUses int,
+		expiresAt time.Time,
+	) (*types.InviteInfo, error)
+	FindInvite(ctx context.Context, id types.ID) (*types.InviteInfo, error)
+	ListInvites(ctx context.Context, projectID types.ID) ([]*types.InviteInfo, error)
+	DeleteInvite(ctx context.Context, id types.ID) error
+	AcceptInvite(ctx context.Context, token string) (*types.ProjectInfo, error)
+
+	CreateAPIKey(
+		ctx context.Context,
+		name, prefix, hash string,
+		scope types.APIKeyScope,
+		expiresAt time.Time,
+	) (*types.APIKeyInfo, error)
+	FindAPIKeyByHash(ctx context.Context, hash string) (*types.APIKeyInfo, error)
+	ListAPIKeys(ctx context.Context) ([]*types.APIKeyInfo, error)
+	UpdateAPIKeyLastUsed(ctx context.Context, id types.ID, lastUsedAt time.Time) error
+	DeleteAPIKey(ctx context.Context, id types.ID) error
 
-	CreateTemplate(ctx context.Context, name, contents string) (*types.TemplateInfo, error)
+	CreateTemplate(ctx context.Context, name, contents string, public bool) (*types.TemplateInfo, error)
 	FindTemplate(ctx context.Context, id types.ID) (*types.TemplateInfo, error)
+	ListTemplates(ctx context.Context) ([]*types.TemplateInfo, error)
+	UpdateTemplate(ctx context.Context, id types.ID, name, contents string, public bool) error
+	DeleteTemplate(ctx context.Context, id types.ID) error
 }
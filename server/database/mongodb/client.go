@@ -19,6 +19,8 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -74,48 +76,1131 @@ func (c *Client) Dial(ctx context.Context) error {
 	log.Logger.Info("Connected to MongoDB")
 
 	c.client = client
+	if err := c.migrate(ctx); err != nil {
+		log.Logger.Errorf("Could not migrate MongoDB: %s\n", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Close all resources of this client.
+func (c *Client) Close(ctx context.Context) error {
+	if err := c.client.Disconnect(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateProject creates a new project of the given name. If organizationID is
+// given, the project is owned by the organization, and the user must be a
+// member of it. If forkedFrom is given, it is recorded as the project that the
+// new project is copied from.
+func (c *Client) CreateProject(
+	ctx context.Context,
+	name string,
+	organizationID types.ID,
+	forkedFrom types.ID,
+) (*types.ProjectInfo, error) {
+	owner := types.UserIDFromCtx(ctx)
+	now := time.Now()
+	doc := bson.M{
+		"name":       name,
+		"owner":      owner,
+		"status":     "created",
+		"created_at": now,
+		"updated_at": now,
+	}
+	if organizationID != "" {
+		if _, err := c.FindOrganization(ctx, organizationID); err != nil {
+			return nil, err
+		}
+		orgObjectID, err := primitive.ObjectIDFromHex(organizationID.String())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", organizationID, database.ErrInvalidID)
+		}
+		doc["organization_id"] = orgObjectID
+	}
+	if forkedFrom != "" {
+		forkedObjectID, err := primitive.ObjectIDFromHex(forkedFrom.String())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", forkedFrom, database.ErrInvalidID)
+		}
+		doc["forked_from"] = forkedObjectID
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("projects").InsertOne(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ProjectInfo{
+		ID:             types.ID(result.InsertedID.(primitive.ObjectID).Hex()),
+		Name:           name,
+		Owner:          owner,
+		OrganizationID: organizationID,
+		ForkedFrom:     forkedFrom,
+		Status:         "created",
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// FindProject returns the project of the given ID. Projects shared with the
+// user as a collaborator or through organizations can also be found.
+func (c *Client) FindProject(ctx context.Context, id types.ID) (*types.ProjectInfo, error) {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	accessible, err := c.accessibleBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.client.Database(c.config.Database).Collection("projects").FindOne(ctx, bson.M{
+		"_id":    objectID,
+		"$or":    accessible,
+		"status": "created",
+	}, options.FindOne())
+
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", id, database.ErrNotFound)
+		}
+		return nil, result.Err()
+	}
+
+	return decodeProject(result)
+}
+
+// ListProjects returns a page of the list of projects that the user can access
+// in the scope of the given options, and the token of the next page. The token
+// is empty if there is no next page.
+func (c *Client) ListProjects(
+	ctx context.Context,
+	opts *database.ListProjectsOptions,
+) ([]*types.ProjectInfo, string, error) {
+	accessible, err := c.accessibleBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter := bson.M{
+		"$or":    accessible,
+		"status": "created",
+	}
+	switch scope := opts.Scope; {
+	case scope == "" || scope == database.ScopeAll:
+	case scope == database.ScopePersonal:
+		filter["organization_id"] = bson.M{"$exists": false}
+	case strings.HasPrefix(scope, database.ScopeOrganizationPrefix):
+		orgID := strings.TrimPrefix(scope, database.ScopeOrganizationPrefix)
+		orgObjectID, err := primitive.ObjectIDFromHex(orgID)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", orgID, database.ErrInvalidID)
+		}
+		filter["organization_id"] = orgObjectID
+	default:
+		return nil, "", fmt.Errorf("%s: %w", scope, database.ErrInvalidScope)
+	}
+	if opts.NameContains != "" {
+		filter["name"] = bson.M{"$regex": regexp.QuoteMeta(opts.NameContains), "$options": "i"}
+	}
+	if opts.Tag != "" {
+		filter["tags"] = opts.Tag
+	}
+	if opts.Starred {
+		filter["starred_by"] = types.UserIDFromCtx(ctx)
+	}
+
+	sortBy := opts.SortBy
+	if sortBy == "" {
+		sortBy = database.SortByCreatedAt
+	}
+	if sortBy != database.SortByName &&
+		sortBy != database.SortByCreatedAt &&
+		sortBy != database.SortByUpdatedAt {
+		return nil, "", fmt.Errorf("%s: %w", sortBy, database.ErrInvalidSortBy)
+	}
+
+	return c.findProjectsPage(ctx, filter, sortBy, opts.Descending, opts.PageSize, opts.PageToken)
+}
+
+// findProjectsPage returns a page of the projects that match the given filter
+// and the token of the next page. The token is empty if there is no next page.
+func (c *Client) findProjectsPage(
+	ctx context.Context,
+	filter bson.M,
+	sortBy string,
+	descending bool,
+	pageSize int,
+	pageToken string,
+) ([]*types.ProjectInfo, string, error) {
+	// Projects are sorted by the given field and then by their IDs, so the last
+	// project of a page is the cursor of the next.
+	if pageToken != "" {
+		token, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if token.SortBy != sortBy || token.Descending != descending {
+			return nil, "", fmt.Errorf("order changed: %w", database.ErrInvalidPageToken)
+		}
+		filter = bson.M{"$and": bson.A{filter, token.after()}}
+	}
+
+	if pageSize <= 0 {
+		pageSize = database.DefaultPageSize
+	}
+	if pageSize > database.MaxPageSize {
+		pageSize = database.MaxPageSize
+	}
+
+	order := 1
+	if descending {
+		order = -1
+	}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: sortBy, Value: order}, {Key: "_id", Value: order}}).
+		SetLimit(int64(pageSize + 1))
+
+	cursor, err := c.client.Database(c.config.Database).Collection("projects").Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	var projects []*types.ProjectInfo
+	for cursor.Next(ctx) {
+		project, err := decodeProject(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		projects = append(projects, project)
+	}
+
+	if len(projects) <= pageSize {
+		return projects, "", nil
+	}
+
+	projects = projects[:pageSize]
+	nextPageToken, err := newPageToken(sortBy, descending, projects[pageSize-1]).encode()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return projects, nextPageToken, nil
+}
+
+// ListDeletedProjects returns the list of deleted projects that the user can
+// restore.
+func (c *Client) ListDeletedProjects(ctx context.Context) ([]*types.ProjectInfo, error) {
+	owned, err := c.ownedBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := c.client.Database(c.config.Database).Collection("projects").Find(ctx, bson.M{
+		"$or":    owned,
+		"status": "deleted",
+	}, options.Find())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	var projects []*types.ProjectInfo
+	for cursor.Next(ctx) {
+		project, err := decodeProject(cursor)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// UpdateProject updates the given fields of the project. Only the owner,
+// editors and the members of the organization of the project can update the
+// project, while every user who can access the project can star it. The
+// fields are metadata, so updated_at and last_editor, which track the edits
+// of the contents, are left unchanged.
+func (c *Client) UpdateProject(ctx context.Context, id types.ID, update *database.ProjectUpdate) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	userID := types.UserIDFromCtx(ctx)
+	var permitted bson.A
+	if update.IsStarOnly() {
+		permitted, err = c.accessibleBy(ctx, userID)
+	} else {
+		permitted, err = c.writableBy(ctx, userID)
+	}
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{}
+	if update.Name != nil {
+		set["name"] = *update.Name
+	}
+	if update.Description != nil {
+		set["description"] = *update.Description
+	}
+	if update.Tags != nil {
+		set["tags"] = types.NormalizeTags(*update.Tags)
+	}
+
+	// Each field is updated with its own operator, so that concurrent updates
+	// of different fields do not overwrite each other.
+	doc := bson.M{}
+	if len(set) > 0 {
+		doc["$set"] = set
+	}
+	if update.Starred != nil {
+		if *update.Starred {
+			doc["$addToSet"] = bson.M{"starred_by": userID}
+		} else {
+			doc["$pull"] = bson.M{"starred_by": userID}
+		}
+	}
+
+	filter := bson.M{
+		"_id":    objectID,
+		"$or":    permitted,
+		"status": "created",
+	}
+	collection := c.client.Database(c.config.Database).Collection("projects")
+
+	var result *mongo.SingleResult
+	if len(doc) == 0 {
+		result = collection.FindOne(ctx, filter)
+	} else {
+		result = collection.FindOneAndUpdate(ctx, filter, doc)
+	}
+
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+		}
+		return result.Err()
+	}
+
+	return nil
+}
+
+// DeleteProject deletes the given project. Only the owner and the admins of the
+// organization of the project can delete the project.
+func (c *Client) DeleteProject(ctx context.Context, id types.ID) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return err
+	}
+
+	owned, err := c.ownedBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, bson.M{
+		"_id": objectID,
+		"$or": owned,
+	}, bson.M{
+		"$set": bson.M{
+			"status":     "deleted",
+			"deleted_at": time.Now(),
+		},
+	})
+
+	return err
+}
+
+// RemoveProject permanently removes the given project of the user. It is used
+// to roll back the creation of the project when its document cannot be
+// written, so only the owner can remove the project.
+func (c *Client) RemoveProject(ctx context.Context, id types.ID) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("projects").DeleteOne(ctx, bson.M{
+		"_id":   objectID,
+		"owner": types.UserIDFromCtx(ctx),
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// ListAllProjects returns a page of the projects of all users that match the
+// given filter, and the token of the next page. The token is empty if there is
+// no next page. Projects are sorted by the time they were created.
+func (c *Client) ListAllProjects(
+	ctx context.Context,
+	filter *database.ProjectFilter,
+) ([]*types.ProjectInfo, string, error) {
+	query := bson.M{}
+	if filter.Owner != "" {
+		query["owner"] = filter.Owner
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.Name != "" {
+		query["name"] = bson.M{"$regex": regexp.QuoteMeta(filter.Name), "$options": "i"}
+	}
+
+	return c.findProjectsPage(ctx, query, database.SortByCreatedAt, false, filter.PageSize, filter.PageToken)
+}
+
+// ForceDeleteProject deletes the given project regardless of its owner.
+func (c *Client) ForceDeleteProject(ctx context.Context, id types.ID) error {
+	return c.setProjectStatus(ctx, id, bson.M{
+		"status": "created",
+	}, bson.M{
+		"$set": bson.M{
+			"status":     "deleted",
+			"deleted_at": time.Now(),
+		},
+	})
+}
+
+// ForceRestoreProject restores the given deleted project regardless of its
+// owner.
+func (c *Client) ForceRestoreProject(ctx context.Context, id types.ID) error {
+	return c.setProjectStatus(ctx, id, bson.M{
+		"status": "deleted",
+	}, restoreUpdate)
+}
+
+// RestoreProject restores the given deleted project. Only the owner and the
+// admins of the organization of the project can restore the project.
+func (c *Client) RestoreProject(ctx context.Context, id types.ID) error {
+	owned, err := c.ownedBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return err
+	}
+
+	return c.setProjectStatus(ctx, id, bson.M{
+		"$or":    owned,
+		"status": "deleted",
+	}, restoreUpdate)
+}
+
+// restoreUpdate is the update that restores deleted projects.
+var restoreUpdate = bson.M{
+	"$set": bson.M{
+		"status": "created",
+	},
+	"$unset": bson.M{
+		"deleted_at": "",
+	},
+}
+
+// setProjectStatus applies the given update to the project of the given ID if
+// it matches the given filter.
+func (c *Client) setProjectStatus(ctx context.Context, id types.ID, filter bson.M, update bson.M) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+	filter["_id"] = objectID
+
+	result, err := c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// ForceTransferProject changes the owner of the given project regardless of
+// its current owner.
+func (c *Client) ForceTransferProject(ctx context.Context, id types.ID, newOwner string) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	result := c.client.Database(c.config.Database).Collection("projects").FindOne(ctx, bson.M{
+		"_id":    objectID,
+		"status": "created",
+	}, options.FindOne())
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+		}
+		return result.Err()
+	}
+
+	project, err := decodeProject(result)
+	if err != nil {
+		return err
+	}
+
+	return c.transferProject(ctx, id, project.Owner, newOwner)
+}
+
+// TransferProject changes the owner of the given project. Only the owner of
+// the project can transfer the project, even if the project belongs to an
+// organization whose admins can otherwise manage it.
+func (c *Client) TransferProject(ctx context.Context, id types.ID, newOwner string) error {
+	return c.transferProject(ctx, id, types.UserIDFromCtx(ctx), newOwner)
+}
+
+// transferProject changes the owner of the given project from the given owner
+// to the new owner, and records the transfer in the project. The project is
+// updated only if it is still owned by the given owner, so that concurrent
+// transfers cannot be lost. If the new owner is a collaborator of the project,
+// the user is removed from the collaborators.
+func (c *Client) transferProject(ctx context.Context, id types.ID, from, newOwner string) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+	if newOwner == "" {
+		return fmt.Errorf("empty new owner: %w", database.ErrInvalidOwner)
+	}
+	if newOwner == from {
+		return fmt.Errorf("%s is already the owner: %w", newOwner, types.ErrInvalidRole)
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, bson.M{
+		"_id":    objectID,
+		"owner":  from,
+		"status": "created",
+	}, bson.M{
+		"$set": bson.M{
+			"owner": newOwner,
+		},
+		"$pull": bson.M{
+			"collaborators": bson.M{"user_id": newOwner},
+		},
+		"$push": bson.M{
+			"transfers": bson.M{
+				"from":           from,
+				"to":             newOwner,
+				"transferred_by": types.UserIDFromCtx(ctx),
+				"transferred_at": time.Now(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// CountProjectsByStatus returns the number of projects of each status.
+func (c *Client) CountProjectsByStatus(ctx context.Context) (map[string]int, error) {
+	cursor, err := c.client.Database(c.config.Database).Collection("projects").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$status",
+			"count": bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	counts := make(map[string]int)
+	for cursor.Next(ctx) {
+		group := struct {
+			Status string `bson:"_id"`
+			Count  int    `bson:"count"`
+		}{}
+		if err := cursor.Decode(&group); err != nil {
+			return nil, err
+		}
+		counts[group.Status] = group.Count
+	}
+
+	return counts, nil
+}
+
+// ListProjectsDeletedBefore returns the list of projects of all users that
+// were deleted before the given time, including the projects claimed for
+// purging whose purge has not been finished.
+func (c *Client) ListProjectsDeletedBefore(
+	ctx context.Context,
+	deletedBefore time.Time,
+) ([]*types.ProjectInfo, error) {
+	cursor, err := c.client.Database(c.config.Database).Collection("projects").Find(ctx, bson.M{
+		"status":     bson.M{"$in": bson.A{"deleted", "purging"}},
+		"deleted_at": bson.M{"$lt": deletedBefore},
+	}, options.Find())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	var projects []*types.ProjectInfo
+	for cursor.Next(ctx) {
+		project, err := decodeProject(cursor)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// ClaimProjectForPurge marks the given project deleted before the given time as
+// purging, so that it can no longer be restored. A project that has already
+// been claimed can be claimed again to retry the purge.
+func (c *Client) ClaimProjectForPurge(ctx context.Context, id types.ID, deletedBefore time.Time) error {
+	return c.setProjectStatus(ctx, id, bson.M{
+		"status":     bson.M{"$in": bson.A{"deleted", "purging"}},
+		"deleted_at": bson.M{"$lt": deletedBefore},
+	}, bson.M{
+		"$set": bson.M{"status": "purging"},
+	})
+}
+
+// PurgeProject permanently removes the given project claimed for purging and
+// its invites.
+func (c *Client) PurgeProject(ctx context.Context, id types.ID) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("projects").DeleteOne(ctx, bson.M{
+		"_id":    objectID,
+		"status": "purging",
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
+	_, err = c.client.Database(c.config.Database).Collection("invites").DeleteMany(ctx, bson.M{
+		"project_id": objectID,
+	})
+	return err
+}
+
+// RecordProjectEdit records that the given user edited the document of the
+// project. To reduce writes on frequent edits, the record is skipped if the
+// same user has edited the project within the given interval.
+func (c *Client) RecordProjectEdit(
+	ctx context.Context,
+	id types.ID,
+	editor string,
+	editedAt time.Time,
+	interval time.Duration,
+) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	_, err = c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, bson.M{
+		"_id":    objectID,
+		"status": "created",
+		"$or": bson.A{
+			bson.M{"last_editor": bson.M{"$ne": editor}},
+			bson.M{"updated_at": bson.M{"$lte": editedAt.Add(-interval)}},
+		},
+	}, bson.M{
+		"$set": bson.M{
+			"updated_at":  editedAt,
+			"last_editor": editor,
+		},
+	})
+
+	return err
+}
+
+// AddCollaborator adds the given user to the collaborators of the project with
+// the given role. If the user is already a collaborator, the role is changed.
+// Only the owner and the admins of the organization of the project can add
+// collaborators.
+func (c *Client) AddCollaborator(
+	ctx context.Context,
+	id types.ID,
+	userID string,
+	role types.Role,
+) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	if role != types.RoleEditor && role != types.RoleViewer {
+		return fmt.Errorf("%s: %w", role, types.ErrInvalidRole)
+	}
+	if userID == types.UserIDFromCtx(ctx) {
+		return fmt.Errorf("%s is the owner: %w", userID, types.ErrInvalidRole)
+	}
+
+	owned, err := c.ownedBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return err
+	}
+
+	collection := c.client.Database(c.config.Database).Collection("projects")
+	result, err := collection.UpdateOne(ctx, bson.M{
+		"_id":                   objectID,
+		"$or":                   owned,
+		"status":                "created",
+		"collaborators.user_id": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"collaborators.$.role": role,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	result, err = collection.UpdateOne(ctx, bson.M{
+		"_id":                   objectID,
+		"$or":                   owned,
+		"owner":                 bson.M{"$ne": userID},
+		"status":                "created",
+		"collaborators.user_id": bson.M{"$ne": userID},
+	}, bson.M{
+		"$push": bson.M{
+			"collaborators": bson.M{
+				"user_id": userID,
+				"role":    role,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// RemoveCollaborator removes the given user from the collaborators of the
+// project. The owner and the admins of the organization of the project can
+// remove any collaborator, and collaborators can remove themselves.
+func (c *Client) RemoveCollaborator(ctx context.Context, id types.ID, userID string) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	owned, err := c.ownedBy(ctx, types.UserIDFromCtx(ctx))
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"_id":    objectID,
+		"$or":    owned,
+		"status": "created",
+	}
+	if userID == types.UserIDFromCtx(ctx) {
+		filter = bson.M{
+			"_id":                   objectID,
+			"collaborators.user_id": userID,
+			"status":                "created",
+		}
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{
+			"collaborators": bson.M{"user_id": userID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return fmt.Errorf("%s: %w", userID, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// CreateInvite creates a new invite to the given project.
+func (c *Client) CreateInvite(
+	ctx context.Context,
+	projectID types.ID,
+	token string,
+	role types.Role,
+	maxUses int,
+	expiresAt time.Time,
+) (*types.InviteInfo, error) {
+	projectObjectID, err := primitive.ObjectIDFromHex(projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", projectID, database.ErrInvalidID)
+	}
+
+	createdBy := types.UserIDFromCtx(ctx)
+	now := time.Now()
+	result, err := c.client.Database(c.config.Database).Collection("invites").InsertOne(ctx, bson.M{
+		"project_id": projectObjectID,
+		"token":      token,
+		"role":       role,
+		"max_uses":   maxUses,
+		"uses":       0,
+		"created_by": createdBy,
+		"expires_at": expiresAt,
+		"created_at": now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.InviteInfo{
+		ID:        types.ID(result.InsertedID.(primitive.ObjectID).Hex()),
+		ProjectID: projectID,
+		Token:     token,
+		Role:      role,
+		MaxUses:   maxUses,
+		CreatedBy: createdBy,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, nil
+}
+
+// FindInvite returns the invite of the given ID.
+func (c *Client) FindInvite(ctx context.Context, id types.ID) (*types.InviteInfo, error) {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	result := c.client.Database(c.config.Database).Collection("invites").FindOne(ctx, bson.M{
+		"_id": objectID,
+	}, options.FindOne())
+
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", id, database.ErrNotFound)
+		}
+		return nil, result.Err()
+	}
+
+	return decodeInvite(result)
+}
+
+// ListInvites returns the invites to the given project.
+func (c *Client) ListInvites(ctx context.Context, projectID types.ID) ([]*types.InviteInfo, error) {
+	projectObjectID, err := primitive.ObjectIDFromHex(projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", projectID, database.ErrInvalidID)
+	}
+
+	cursor, err := c.client.Database(c.config.Database).Collection("invites").Find(ctx, bson.M{
+		"project_id": projectObjectID,
+	}, options.Find())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	var invites []*types.InviteInfo
+	for cursor.Next(ctx) {
+		invite, err := decodeInvite(cursor)
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+
+	return invites, nil
+}
+
+// DeleteInvite deletes the given invite.
+func (c *Client) DeleteInvite(ctx context.Context, id types.ID) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("invites").DeleteOne(ctx, bson.M{
+		"_id": objectID,
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
 	return nil
 }
 
-// Close all resources of this client.
-func (c *Client) Close(ctx context.Context) error {
-	if err := c.client.Disconnect(ctx); err != nil {
+// AcceptInvite adds the user to the collaborators of the project with the
+// role of the invite of the given token. The use of the invite is counted
+// atomically, so the invite cannot be used more than its max uses. If the user
+// can already access the project, the use is not counted and the role is not
+// changed.
+func (c *Client) AcceptInvite(ctx context.Context, token string) (*types.ProjectInfo, error) {
+	userID := types.UserIDFromCtx(ctx)
+	invites := c.client.Database(c.config.Database).Collection("invites")
+
+	result := invites.FindOneAndUpdate(ctx, bson.M{
+		"token":      token,
+		"expires_at": bson.M{"$gt": time.Now()},
+		"$or": bson.A{
+			bson.M{"max_uses": 0},
+			bson.M{"$expr": bson.M{"$lt": bson.A{"$uses", "$max_uses"}}},
+		},
+	}, bson.M{
+		"$inc": bson.M{"uses": 1},
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("invite: %w", database.ErrNotFound)
+		}
+		return nil, result.Err()
+	}
+
+	invite, err := decodeInvite(result)
+	if err != nil {
+		return nil, err
+	}
+	inviteObjectID, err := primitive.ObjectIDFromHex(invite.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	projectObjectID, err := primitive.ObjectIDFromHex(invite.ProjectID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, bson.M{
+		"_id":                   projectObjectID,
+		"owner":                 bson.M{"$ne": userID},
+		"collaborators.user_id": bson.M{"$ne": userID},
+		"status":                "created",
+	}, bson.M{
+		"$push": bson.M{
+			"collaborators": bson.M{
+				"user_id": userID,
+				"role":    invite.Role,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated.MatchedCount == 0 {
+		if _, err := invites.UpdateOne(ctx, bson.M{
+			"_id": inviteObjectID,
+		}, bson.M{
+			"$inc": bson.M{"uses": -1},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.FindProject(ctx, invite.ProjectID)
+}
+
+// CreateAPIKey creates a new API key of the user with the given hash.
+func (c *Client) CreateAPIKey(
+	ctx context.Context,
+	name, prefix, hash string,
+	scope types.APIKeyScope,
+	expiresAt time.Time,
+) (*types.APIKeyInfo, error) {
+	owner := types.UserIDFromCtx(ctx)
+	now := time.Now()
+	result, err := c.client.Database(c.config.Database).Collection("api_keys").InsertOne(ctx, bson.M{
+		"name":       name,
+		"owner":      owner,
+		"prefix":     prefix,
+		"hash":       hash,
+		"scope":      scope,
+		"expires_at": expiresAt,
+		"created_at": now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.APIKeyInfo{
+		ID:        types.ID(result.InsertedID.(primitive.ObjectID).Hex()),
+		Name:      name,
+		Owner:     owner,
+		Prefix:    prefix,
+		Hash:      hash,
+		Scope:     scope,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, nil
+}
+
+// FindAPIKeyByHash returns the API key of the given hash. It is used to
+// authenticate the user, so the key is not scoped to the user.
+func (c *Client) FindAPIKeyByHash(ctx context.Context, hash string) (*types.APIKeyInfo, error) {
+	result := c.client.Database(c.config.Database).Collection("api_keys").FindOne(ctx, bson.M{
+		"hash": hash,
+	}, options.FindOne())
+
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("api key: %w", database.ErrNotFound)
+		}
+		return nil, result.Err()
+	}
+
+	return decodeAPIKey(result)
+}
+
+// ListAPIKeys returns the API keys of the user.
+func (c *Client) ListAPIKeys(ctx context.Context) ([]*types.APIKeyInfo, error) {
+	cursor, err := c.client.Database(c.config.Database).Collection("api_keys").Find(ctx, bson.M{
+		"owner": types.UserIDFromCtx(ctx),
+	}, options.Find())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	var keys []*types.APIKeyInfo
+	for cursor.Next(ctx) {
+		key, err := decodeAPIKey(cursor)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// UpdateAPIKeyLastUsed updates the time when the given API key was last used.
+func (c *Client) UpdateAPIKeyLastUsed(ctx context.Context, id types.ID, lastUsedAt time.Time) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	_, err = c.client.Database(c.config.Database).Collection("api_keys").UpdateOne(ctx, bson.M{
+		"_id": objectID,
+	}, bson.M{
+		"$set": bson.M{
+			"last_used_at": lastUsedAt,
+		},
+	})
+	return err
+}
+
+// DeleteAPIKey deletes the given API key of the user.
+func (c *Client) DeleteAPIKey(ctx context.Context, id types.ID) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("api_keys").DeleteOne(ctx, bson.M{
+		"_id":   objectID,
+		"owner": types.UserIDFromCtx(ctx),
+	})
+	if err != nil {
 		return err
 	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
 	return nil
 }
 
-// CreateProject creates a new project of the given name.
-func (c *Client) CreateProject(ctx context.Context, name string) (*types.ProjectInfo, error) {
+// CreateTemplate creates a new template.
+func (c *Client) CreateTemplate(
+	ctx context.Context,
+	name, contents string,
+	public bool,
+) (*types.TemplateInfo, error) {
+	owner := types.UserIDFromCtx(ctx)
 	now := time.Now()
-	result, err := c.client.Database(c.config.Database).Collection("projects").InsertOne(ctx, bson.M{
+	result, err := c.client.Database(c.config.Database).Collection("templates").InsertOne(ctx, bson.M{
 		"name":       name,
-		"owner":      types.UserIDFromCtx(ctx),
-		"status":     "created",
+		"owner":      owner,
+		"contents":   contents,
+		"public":     public,
 		"created_at": now,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.ProjectInfo{
+	return &types.TemplateInfo{
 		ID:        types.ID(result.InsertedID.(primitive.ObjectID).Hex()),
 		Name:      name,
+		Owner:     owner,
+		Contents:  contents,
+		Public:    public,
 		CreatedAt: now,
 	}, nil
 }
 
-// FindProject returns the project of the given ID.
-func (c *Client) FindProject(ctx context.Context, id types.ID) (*types.ProjectInfo, error) {
+// FindTemplate returns the template of the given ID. Only the templates owned
+// by the user or public templates can be found.
+func (c *Client) FindTemplate(ctx context.Context, id types.ID) (*types.TemplateInfo, error) {
 	objectID, err := primitive.ObjectIDFromHex(id.String())
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", id, database.ErrInvalidID)
 	}
 
-	result := c.client.Database(c.config.Database).Collection("projects").FindOne(ctx, bson.M{
-		"_id":    objectID,
-		"owner":  types.UserIDFromCtx(ctx),
-		"status": "created",
+	result := c.client.Database(c.config.Database).Collection("templates").FindOne(ctx, bson.M{
+		"_id": objectID,
+		"$or": bson.A{
+			bson.M{"owner": types.UserIDFromCtx(ctx)},
+			bson.M{"public": true},
+		},
 	}, options.FindOne())
 
 	if result.Err() != nil {
@@ -125,25 +1210,28 @@ func (c *Client) FindProject(ctx context.Context, id types.ID) (*types.ProjectIn
 		return nil, result.Err()
 	}
 
-	project := &types.ProjectInfo{}
+	template := &types.TemplateInfo{}
 	idHolder := struct {
 		ID primitive.ObjectID `bson:"_id"`
 	}{}
 	if err := result.Decode(&idHolder); err != nil {
 		return nil, err
 	}
-	if err := result.Decode(project); err != nil {
+	if err := result.Decode(template); err != nil {
 		return nil, err
 	}
-	project.ID = types.ID(idHolder.ID.Hex())
-	return project, nil
+	template.ID = types.ID(idHolder.ID.Hex())
+	return template, nil
 }
 
-// ListProjects returns the list of projects.
-func (c *Client) ListProjects(ctx context.Context) ([]*types.ProjectInfo, error) {
-	cursor, err := c.client.Database(c.config.Database).Collection("projects").Find(ctx, bson.M{
-		"owner":  types.UserIDFromCtx(ctx),
-		"status": "created",
+// ListTemplates returns the list of templates owned by the user and public
+// templates.
+func (c *Client) ListTemplates(ctx context.Context) ([]*types.TemplateInfo, error) {
+	cursor, err := c.client.Database(c.config.Database).Collection("templates").Find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"owner": types.UserIDFromCtx(ctx)},
+			bson.M{"public": true},
+		},
 	}, options.Find())
 	if err != nil {
 		return nil, err
@@ -154,42 +1242,48 @@ func (c *Client) ListProjects(ctx context.Context) ([]*types.ProjectInfo, error)
 		}
 	}()
 
-	var projects []*types.ProjectInfo
+	var templates []*types.TemplateInfo
 	for cursor.Next(ctx) {
-		var project types.ProjectInfo
+		var template types.TemplateInfo
 		idHolder := struct {
 			ID primitive.ObjectID `bson:"_id"`
 		}{}
 		if err := cursor.Decode(&idHolder); err != nil {
 			return nil, err
 		}
-		if err := cursor.Decode(&project); err != nil {
+		if err := cursor.Decode(&template); err != nil {
 			return nil, err
 		}
-		project.ID = types.ID(idHolder.ID.Hex())
-		projects = append(projects, &project)
+		template.ID = types.ID(idHolder.ID.Hex())
+		templates = append(templates, &template)
 	}
 
-	return projects, nil
+	return templates, nil
 }
 
-// UpdateProject updates the given project.
-func (c *Client) UpdateProject(ctx context.Context, id types.ID, name string) error {
+// UpdateTemplate updates the given template.
+func (c *Client) UpdateTemplate(
+	ctx context.Context,
+	id types.ID,
+	name, contents string,
+	public bool,
+) error {
 	objectID, err := primitive.ObjectIDFromHex(id.String())
 	if err != nil {
 		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
 	}
 
-	result := c.client.Database(c.config.Database).Collection("projects").FindOneAndUpdate(
+	result := c.client.Database(c.config.Database).Collection("templates").FindOneAndUpdate(
 		ctx,
 		bson.M{
-			"_id":    objectID,
-			"owner":  types.UserIDFromCtx(ctx),
-			"status": "created",
+			"_id":   objectID,
+			"owner": types.UserIDFromCtx(ctx),
 		},
 		bson.M{
 			"$set": bson.M{
-				"name": name,
+				"name":     name,
+				"contents": contents,
+				"public":   public,
 			},
 		},
 	)
@@ -204,58 +1298,63 @@ func (c *Client) UpdateProject(ctx context.Context, id types.ID, name string) er
 	return nil
 }
 
-// DeleteProject deletes the given project.
-func (c *Client) DeleteProject(ctx context.Context, id types.ID) error {
+// DeleteTemplate deletes the given template.
+func (c *Client) DeleteTemplate(ctx context.Context, id types.ID) error {
 	objectID, err := primitive.ObjectIDFromHex(id.String())
 	if err != nil {
-		return err
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
 	}
 
-	_, err = c.client.Database(c.config.Database).Collection("projects").UpdateOne(ctx, bson.M{
+	result, err := c.client.Database(c.config.Database).Collection("templates").DeleteOne(ctx, bson.M{
 		"_id":   objectID,
 		"owner": types.UserIDFromCtx(ctx),
-	}, bson.M{
-		"$set": bson.M{
-			"status":     "deleted",
-			"deleted_at": time.Now(),
-		},
 	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
 
-	return err
+	return nil
 }
 
-// CreateTemplate creates a new template.
-func (c *Client) CreateTemplate(ctx context.Context, name, contents string) (*types.TemplateInfo, error) {
-	owner := types.UserIDFromCtx(ctx)
+// CreateOrganization creates a new organization of the given name. The user
+// becomes its admin.
+func (c *Client) CreateOrganization(ctx context.Context, name string) (*types.OrganizationInfo, error) {
+	members := []*types.Member{{
+		UserID: types.UserIDFromCtx(ctx),
+		Role:   types.OrganizationAdmin,
+	}}
 	now := time.Now()
-	result, err := c.client.Database(c.config.Database).Collection("projects").InsertOne(ctx, bson.M{
+	result, err := c.client.Database(c.config.Database).Collection("organizations").InsertOne(ctx, bson.M{
 		"name":       name,
-		"owner":      owner,
-		"contents":   contents,
+		"members":    members,
 		"created_at": now,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.TemplateInfo{
+	return &types.OrganizationInfo{
 		ID:        types.ID(result.InsertedID.(primitive.ObjectID).Hex()),
 		Name:      name,
-		Owner:     owner,
-		Contents:  contents,
+		Members:   members,
 		CreatedAt: now,
 	}, nil
 }
 
-// FindTemplate returns the template of the given ID.
-func (c *Client) FindTemplate(ctx context.Context, id types.ID) (*types.TemplateInfo, error) {
+// FindOrganization returns the organization of the given ID. Only the members
+// can find the organization.
+func (c *Client) FindOrganization(ctx context.Context, id types.ID) (*types.OrganizationInfo, error) {
 	objectID, err := primitive.ObjectIDFromHex(id.String())
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", id, database.ErrInvalidID)
 	}
 
-	result := c.client.Database(c.config.Database).Collection("templates").FindOne(ctx, bson.M{
-		"_id": objectID,
+	result := c.client.Database(c.config.Database).Collection("organizations").FindOne(ctx, bson.M{
+		"_id":             objectID,
+		"members.user_id": types.UserIDFromCtx(ctx),
 	}, options.FindOne())
 
 	if result.Err() != nil {
@@ -265,16 +1364,298 @@ func (c *Client) FindTemplate(ctx context.Context, id types.ID) (*types.Template
 		return nil, result.Err()
 	}
 
-	template := &types.TemplateInfo{}
+	return decodeOrganization(result)
+}
+
+// ListOrganizations returns the organizations that the user is a member of.
+func (c *Client) ListOrganizations(ctx context.Context) ([]*types.OrganizationInfo, error) {
+	return c.organizationsOf(ctx, types.UserIDFromCtx(ctx))
+}
+
+// AddMember adds the given user to the members of the organization with the
+// given role. If the user is already a member, the role is changed. Only the
+// admins can add members.
+func (c *Client) AddMember(
+	ctx context.Context,
+	id types.ID,
+	userID string,
+	role types.OrganizationRole,
+) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	if role != types.OrganizationAdmin && role != types.OrganizationMember {
+		return fmt.Errorf("%s: %w", role, types.ErrInvalidRole)
+	}
+	if userID == types.UserIDFromCtx(ctx) {
+		return fmt.Errorf("%s cannot change their own role: %w", userID, types.ErrInvalidRole)
+	}
+
+	admin := bson.M{"$elemMatch": bson.M{
+		"user_id": types.UserIDFromCtx(ctx),
+		"role":    types.OrganizationAdmin,
+	}}
+	collection := c.client.Database(c.config.Database).Collection("organizations")
+	result, err := collection.UpdateOne(ctx, bson.M{
+		"_id":             objectID,
+		"members":         admin,
+		"members.user_id": userID,
+	}, bson.M{
+		"$set": bson.M{
+			"members.$[member].role": role,
+		},
+	}, options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"member.user_id": userID}},
+	}))
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	result, err = collection.UpdateOne(ctx, bson.M{
+		"_id":             objectID,
+		"members":         admin,
+		"members.user_id": bson.M{"$ne": userID},
+	}, bson.M{
+		"$push": bson.M{
+			"members": bson.M{
+				"user_id": userID,
+				"role":    role,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// RemoveMember removes the given user from the members of the organization.
+// The admins can remove any member, and members can leave the organization
+// unless they are the last admin.
+func (c *Client) RemoveMember(ctx context.Context, id types.ID, userID string) error {
+	objectID, err := primitive.ObjectIDFromHex(id.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", id, database.ErrInvalidID)
+	}
+
+	filter := bson.M{
+		"_id": objectID,
+		"members": bson.M{"$elemMatch": bson.M{
+			"user_id": types.UserIDFromCtx(ctx),
+			"role":    types.OrganizationAdmin,
+		}},
+	}
+	if userID == types.UserIDFromCtx(ctx) {
+		filter = bson.M{
+			"_id": objectID,
+			"$or": bson.A{
+				bson.M{"members": bson.M{"$elemMatch": bson.M{
+					"user_id": userID,
+					"role":    types.OrganizationMember,
+				}}},
+				bson.M{"members": bson.M{"$elemMatch": bson.M{
+					"user_id": bson.M{"$ne": userID},
+					"role":    types.OrganizationAdmin,
+				}}},
+			},
+		}
+	}
+
+	result, err := c.client.Database(c.config.Database).Collection("organizations").UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{
+			"members": bson.M{"user_id": userID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount == 0 {
+		return fmt.Errorf("%s: %w", userID, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// organizationsOf returns the organizations that the given user is a member
+// of.
+func (c *Client) organizationsOf(ctx context.Context, userID string) ([]*types.OrganizationInfo, error) {
+	cursor, err := c.client.Database(c.config.Database).Collection("organizations").Find(ctx, bson.M{
+		"members.user_id": userID,
+	}, options.Find())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	var organizations []*types.OrganizationInfo
+	for cursor.Next(ctx) {
+		organization, err := decodeOrganization(cursor)
+		if err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, organization)
+	}
+
+	return organizations, nil
+}
+
+// accessibleBy returns the conditions of the projects that the given user can
+// access.
+func (c *Client) accessibleBy(ctx context.Context, userID string) (bson.A, error) {
+	orgIDs, err := c.organizationIDsOf(ctx, userID, types.OrganizationMember)
+	if err != nil {
+		return nil, err
+	}
+
+	return bson.A{
+		bson.M{"owner": userID},
+		bson.M{"collaborators.user_id": userID},
+		bson.M{"organization_id": bson.M{"$in": orgIDs}},
+	}, nil
+}
+
+// writableBy returns the conditions of the projects that the given user can
+// write.
+func (c *Client) writableBy(ctx context.Context, userID string) (bson.A, error) {
+	orgIDs, err := c.organizationIDsOf(ctx, userID, types.OrganizationMember)
+	if err != nil {
+		return nil, err
+	}
+
+	return bson.A{
+		bson.M{"owner": userID},
+		bson.M{"collaborators": bson.M{"$elemMatch": bson.M{
+			"user_id": userID,
+			"role":    types.RoleEditor,
+		}}},
+		bson.M{"organization_id": bson.M{"$in": orgIDs}},
+	}, nil
+}
+
+// ownedBy returns the conditions of the projects that the given user owns.
+func (c *Client) ownedBy(ctx context.Context, userID string) (bson.A, error) {
+	orgIDs, err := c.organizationIDsOf(ctx, userID, types.OrganizationAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return bson.A{
+		bson.M{"owner": userID},
+		bson.M{"organization_id": bson.M{"$in": orgIDs}},
+	}, nil
+}
+
+// organizationIDsOf returns the IDs of the organizations in which the given
+// user has the given role. Admins are also members.
+func (c *Client) organizationIDsOf(
+	ctx context.Context,
+	userID string,
+	role types.OrganizationRole,
+) (bson.A, error) {
+	organizations, err := c.organizationsOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgIDs := bson.A{}
+	for _, organization := range organizations {
+		if r, _ := organization.RoleOf(userID); role == types.OrganizationAdmin && r != role {
+			continue
+		}
+
+		objectID, err := primitive.ObjectIDFromHex(organization.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		orgIDs = append(orgIDs, objectID)
+	}
+
+	return orgIDs, nil
+}
+
+// decodeProject decodes the project from the given result.
+func decodeProject(result interface{ Decode(v interface{}) error }) (*types.ProjectInfo, error) {
+	project := &types.ProjectInfo{}
+	idHolder := struct {
+		ID             primitive.ObjectID `bson:"_id"`
+		OrganizationID primitive.ObjectID `bson:"organization_id"`
+		ForkedFrom     primitive.ObjectID `bson:"forked_from"`
+	}{}
+	if err := result.Decode(&idHolder); err != nil {
+		return nil, err
+	}
+	if err := result.Decode(project); err != nil {
+		return nil, err
+	}
+	project.ID = types.ID(idHolder.ID.Hex())
+	if !idHolder.OrganizationID.IsZero() {
+		project.OrganizationID = types.ID(idHolder.OrganizationID.Hex())
+	}
+	if !idHolder.ForkedFrom.IsZero() {
+		project.ForkedFrom = types.ID(idHolder.ForkedFrom.Hex())
+	}
+	return project, nil
+}
+
+// decodeOrganization decodes the organization from the given result.
+func decodeOrganization(result interface{ Decode(v interface{}) error }) (*types.OrganizationInfo, error) {
+	organization := &types.OrganizationInfo{}
 	idHolder := struct {
 		ID primitive.ObjectID `bson:"_id"`
 	}{}
 	if err := result.Decode(&idHolder); err != nil {
 		return nil, err
 	}
-	if err := result.Decode(template); err != nil {
+	if err := result.Decode(organization); err != nil {
 		return nil, err
 	}
-	template.ID = types.ID(idHolder.ID.Hex())
-	return template, nil
+	organization.ID = types.ID(idHolder.ID.Hex())
+	return organization, nil
+}
+
+// decodeInvite decodes the invite from the given result.
+func decodeInvite(result interface{ Decode(v interface{}) error }) (*types.InviteInfo, error) {
+	invite := &types.InviteInfo{}
+	idHolder := struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		ProjectID primitive.ObjectID `bson:"project_id"`
+	}{}
+	if err := result.Decode(&idHolder); err != nil {
+		return nil, err
+	}
+	if err := result.Decode(invite); err != nil {
+		return nil, err
+	}
+	invite.ID = types.ID(idHolder.ID.Hex())
+	invite.ProjectID = types.ID(idHolder.ProjectID.Hex())
+	return invite, nil
+}
+
+// decodeAPIKey decodes the API key from the given result.
+func decodeAPIKey(result interface{ Decode(v interface{}) error }) (*types.APIKeyInfo, error) {
+	key := &types.APIKeyInfo{}
+	idHolder := struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}{}
+	if err := result.Decode(&idHolder); err != nil {
+		return nil, err
+	}
+	if err := result.Decode(key); err != nil {
+		return nil, err
+	}
+	key.ID = types.ID(idHolder.ID.Hex())
+	return key, nil
 }
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/metis-labs/metis-server/internal/log"
+)
+
+// migrate brings the documents written by older versions of the server up to
+// date. Each step must be idempotent, as it runs whenever the client dials.
+func (c *Client) migrate(ctx context.Context) error {
+	// Projects created before updated_at was introduced do not have it, and
+	// they would be skipped when listing projects by updated_at in pages, so
+	// it is filled with created_at.
+	result, err := c.client.Database(c.config.Database).Collection("projects").UpdateMany(ctx, bson.M{
+		"updated_at": bson.M{"$exists": false},
+	}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"updated_at": "$created_at"}}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.ModifiedCount > 0 {
+		log.Logger.Infof("Migrated updated_at of %d projects", result.ModifiedCount)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongodb
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+// pageToken is the cursor of listing projects. It holds the sort key and the
+// ID of the last project of the previous page.
+type pageToken struct {
+	SortBy     string             `bson:"s"`
+	Descending bool               `bson:"d"`
+	Name       string             `bson:"n"`
+	Time       time.Time          `bson:"t"`
+	ID         primitive.ObjectID `bson:"i"`
+}
+
+// newPageToken creates a new pageToken after the given project.
+func newPageToken(sortBy string, descending bool, project *types.ProjectInfo) *pageToken {
+	token := &pageToken{
+		SortBy:     sortBy,
+		Descending: descending,
+	}
+	token.ID, _ = primitive.ObjectIDFromHex(project.ID.String())
+
+	switch sortBy {
+	case database.SortByName:
+		token.Name = project.Name
+	case database.SortByCreatedAt:
+		token.Time = project.CreatedAt
+	case database.SortByUpdatedAt:
+		token.Time = project.UpdatedAt
+	}
+
+	return token
+}
+
+// decodePageToken decodes the given string into a pageToken.
+func decodePageToken(s string) (*pageToken, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), database.ErrInvalidPageToken)
+	}
+
+	token := &pageToken{}
+	if err := bson.Unmarshal(bytes, token); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), database.ErrInvalidPageToken)
+	}
+
+	return token, nil
+}
+
+// encode encodes this token into a string.
+func (t *pageToken) encode() (string, error) {
+	bytes, err := bson.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// after returns the filter of the projects that come after this token.
+func (t *pageToken) after() bson.M {
+	op := "$gt"
+	if t.Descending {
+		op = "$lt"
+	}
+
+	var value interface{} = t.Time
+	if t.SortBy == database.SortByName {
+		value = t.Name
+	}
+
+	return bson.M{
+		"$or": bson.A{
+			bson.M{t.SortBy: bson.M{op: value}},
+			bson.M{t.SortBy: value, "_id": bson.M{op: t.ID}},
+		},
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invites
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/projects"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+const (
+	// DefaultExpiry is the period until the invite expires if it is not
+	// given.
+	DefaultExpiry = 7 * 24 * time.Hour
+
+	tokenSize = 32
+)
+
+// ErrInvalidInvite is returned when the expiry or the max uses of the invite is
+// not valid.
+var ErrInvalidInvite = errors.New("invalid invite")
+
+// Create creates a new invite to the given project with a random token. If
+// expiresAt is zero, the invite expires after DefaultExpiry. Only the owner
+// of the project can create invites.
+func Create(
+	ctx context.Context,
+	db database.Database,
+	projectID types.ID,
+	role types.Role,
+	maxUses int,
+	expiresAt time.Time,
+) (*types.InviteInfo, error) {
+	if role != types.RoleEditor && role != types.RoleViewer {
+		return nil, fmt.Errorf("%s: %w", role, types.ErrInvalidRole)
+	}
+	if maxUses < 0 {
+		return nil, fmt.Errorf("max uses %d: %w", maxUses, ErrInvalidInvite)
+	}
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(DefaultExpiry)
+	} else if expiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("expires at %s: %w", expiresAt, ErrInvalidInvite)
+	}
+
+	if err := checkOwner(ctx, db, projectID); err != nil {
+		return nil, err
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return db.CreateInvite(ctx, projectID, token, role, maxUses, expiresAt)
+}
+
+// List returns the invites to the given project. Only the owner of the project
+// can list invites.
+func List(ctx context.Context, db database.Database, projectID types.ID) ([]*types.InviteInfo, error) {
+	if err := checkOwner(ctx, db, projectID); err != nil {
+		return nil, err
+	}
+
+	return db.ListInvites(ctx, projectID)
+}
+
+// Revoke deletes the given invite. Only the owner of the project can revoke
+// invites.
+func Revoke(ctx context.Context, db database.Database, id types.ID) error {
+	invite, err := db.FindInvite(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := checkOwner(ctx, db, invite.ProjectID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return fmt.Errorf("%s: %w", id, database.ErrNotFound)
+		}
+		return err
+	}
+
+	return db.DeleteInvite(ctx, id)
+}
+
+// checkOwner checks that the user is the owner of the given project. Projects
+// of other users are reported as not found.
+func checkOwner(ctx context.Context, db database.Database, projectID types.ID) error {
+	project, err := db.FindProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	role, _, err := projects.RoleOf(ctx, db, project)
+	if err != nil {
+		return err
+	}
+	if role != types.RoleOwner {
+		return fmt.Errorf("%s: %w", projectID, database.ErrNotFound)
+	}
+
+	return nil
+}
+
+// newToken returns a new random token that can be used in URLs.
+func newToken() (string, error) {
+	bytes := make([]byte, tokenSize)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,252 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	yorkieJSON "github.com/yorkie-team/yorkie/pkg/document/json"
+
+	"github.com/metis-labs/metis-server/internal/log"
+	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/yorkie"
+)
+
+// ErrInvalidDocument is returned when the document of the project does not
+// have the expected structure.
+var ErrInvalidDocument = errors.New("invalid document")
+
+// withDocument attaches the document of the given project, calls the given
+// function with it and detaches the document.
+func withDocument(
+	ctx context.Context,
+	yorkieConf *yorkie.Config,
+	id types.ID,
+	fn func(doc *document.Document) error,
+) error {
+	// TODO(youngteac.hong): Extract yorkie packages such as database.
+	cli, err := client.Dial(yorkieConf.RPCAddr, client.Option{
+		Token: yorkieConf.WebhookToken,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := cli.Close(); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	if err := cli.Activate(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := cli.Deactivate(ctx); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	doc := document.New(yorkieConf.Collection, id.String())
+	if err := cli.Attach(ctx, doc); err != nil {
+		return err
+	}
+
+	if err := fn(doc); err != nil {
+		return err
+	}
+
+	return cli.Detach(ctx, doc)
+}
+
+// toProject converts the root of the given document into a project.
+func toProject(doc *document.Document) (*types.Project, error) {
+	project, err := objectOf(doc.RootObject(), "project")
+	if err != nil {
+		return nil, err
+	}
+
+	networks, err := objectOf(project, "networks")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &types.Project{
+		ID:       stringOf(project, "id"),
+		Name:     stringOf(project, "name"),
+		Networks: make(map[string]*types.Network),
+	}
+	for nID, elem := range networks.Members() {
+		obj, ok := elem.(*yorkieJSON.Object)
+		if !ok {
+			return nil, fmt.Errorf("networks.%s: %w", nID, ErrInvalidDocument)
+		}
+		network, err := toNetwork(obj)
+		if err != nil {
+			return nil, fmt.Errorf("networks.%s: %w", nID, err)
+		}
+		p.Networks[nID] = network
+	}
+
+	return p, nil
+}
+
+func toNetwork(network *yorkieJSON.Object) (*types.Network, error) {
+	n := &types.Network{
+		ID:           stringOf(network, "id"),
+		Name:         stringOf(network, "name"),
+		Dependencies: &types.Dependencies{},
+		Blocks:       make(map[string]*types.Block),
+		Links:        make(map[string]*types.Link),
+	}
+
+	// dependencies
+	if dependencies, ok := network.Get("dependencies").(*yorkieJSON.Object); ok {
+		n.Dependencies.BuiltInDeps = toDependencies(dependencies, "builtInDeps")
+		n.Dependencies.ThirdPartyDeps = toDependencies(dependencies, "thirdPartyDeps")
+		n.Dependencies.ProjectDeps = toDependencies(dependencies, "projectDeps")
+	}
+
+	// blocks
+	blocks, err := objectOf(network, "blocks")
+	if err != nil {
+		return nil, err
+	}
+	for bID, elem := range blocks.Members() {
+		block, ok := elem.(*yorkieJSON.Object)
+		if !ok {
+			return nil, fmt.Errorf("blocks.%s: %w", bID, ErrInvalidDocument)
+		}
+		n.Blocks[bID] = toBlock(block)
+	}
+
+	// links
+	links, err := objectOf(network, "links")
+	if err != nil {
+		return nil, err
+	}
+	for lID, elem := range links.Members() {
+		link, ok := elem.(*yorkieJSON.Object)
+		if !ok {
+			return nil, fmt.Errorf("links.%s: %w", lID, ErrInvalidDocument)
+		}
+		n.Links[lID] = &types.Link{
+			ID:   stringOf(link, "id"),
+			From: stringOf(link, "from"),
+			To:   stringOf(link, "to"),
+		}
+	}
+
+	return n, nil
+}
+
+func toDependencies(dependencies *yorkieJSON.Object, k string) map[string]*types.Dependency {
+	deps, ok := dependencies.Get(k).(*yorkieJSON.Object)
+	if !ok {
+		return nil
+	}
+
+	result := make(map[string]*types.Dependency)
+	for dID, elem := range deps.Members() {
+		dep, ok := elem.(*yorkieJSON.Object)
+		if !ok {
+			continue
+		}
+		result[dID] = &types.Dependency{
+			ID:      stringOf(dep, "id"),
+			Name:    stringOf(dep, "name"),
+			Alias:   stringOf(dep, "alias"),
+			Package: stringOf(dep, "package"),
+		}
+	}
+
+	return result
+}
+
+func toBlock(block *yorkieJSON.Object) *types.Block {
+	b := &types.Block{
+		ID:            stringOf(block, "id"),
+		Name:          stringOf(block, "name"),
+		Type:          types.BlockType(stringOf(block, "type")),
+		InitVariables: stringOf(block, "initVariables"),
+		RefNetwork:    stringOf(block, "refNetwork"),
+		Repeats:       intOf(block, "repeats"),
+	}
+
+	if position, ok := block.Get("position").(*yorkieJSON.Object); ok {
+		b.Position = &types.Position{
+			X: intOf(position, "x"),
+			Y: intOf(position, "y"),
+		}
+	}
+
+	if parameters, ok := block.Get("parameters").(*yorkieJSON.Object); ok {
+		b.Parameters = make(types.Parameters)
+		for k, elem := range parameters.Members() {
+			if primitive, ok := elem.(*yorkieJSON.Primitive); ok {
+				b.Parameters[k] = primitive.Value()
+			}
+		}
+	}
+
+	return b
+}
+
+// objectOf returns the object of the given key.
+func objectOf(obj *yorkieJSON.Object, k string) (*yorkieJSON.Object, error) {
+	elem, ok := obj.Get(k).(*yorkieJSON.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", k, ErrInvalidDocument)
+	}
+
+	return elem, nil
+}
+
+// stringOf returns the string of the given key or an empty string if the key
+// does not exist.
+func stringOf(obj *yorkieJSON.Object, k string) string {
+	primitive, ok := obj.Get(k).(*yorkieJSON.Primitive)
+	if !ok {
+		return ""
+	}
+
+	if v, ok := primitive.Value().(string); ok {
+		return v
+	}
+	return ""
+}
+
+// intOf returns the integer of the given key or zero if the key does not exist.
+func intOf(obj *yorkieJSON.Object, k string) int {
+	primitive, ok := obj.Get(k).(*yorkieJSON.Primitive)
+	if !ok {
+		return 0
+	}
+
+	switch v := primitive.Value().(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
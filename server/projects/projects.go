@@ -18,66 +18,203 @@ package projects
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 
-	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 
 	"github.com/metis-labs/metis-server/internal/log"
 	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/templates"
 	"github.com/metis-labs/metis-server/server/types"
 	"github.com/metis-labs/metis-server/server/yorkie"
 )
 
-// Create creates a new project of the given name.
+// ErrUnsupportedParameter is returned when the type of parameter value is not
+// supported.
+var ErrUnsupportedParameter = errors.New("unsupported parameter")
+
+// Create creates a new project of the given name. If organizationID is given,
+// the project is owned by the organization. If template is given, the contents
+// of the project are seeded from the template.
 func Create(
 	ctx context.Context,
 	db database.Database,
 	yorkieConf *yorkie.Config,
 	projectName string,
+	organizationID types.ID,
+	template *types.Project,
 ) (*types.ProjectInfo, error) {
-	projectInfo, err := db.CreateProject(ctx, projectName)
+	projectInfo, err := db.CreateProject(ctx, projectName, organizationID, "")
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO(youngteac.hong): Extract yorkie packages such as database.
-	cli, err := client.Dial(yorkieConf.RPCAddr, client.Option{
-		Token: yorkieConf.WebhookToken,
-	})
+	project := types.NewProject(projectInfo.ID.String(), projectInfo.Name)
+	if template != nil {
+		project.Networks = template.Networks
+	}
+	if err := withDocument(ctx, yorkieConf, projectInfo.ID, func(doc *document.Document) error {
+		return updateProject(doc, project)
+	}); err != nil {
+		// The new project is removed so that it is not left without contents.
+		if removeErr := db.RemoveProject(ctx, projectInfo.ID); removeErr != nil {
+			log.Logger.Errorf("remove project %s: %s", projectInfo.ID, removeErr.Error())
+		}
+		return nil, err
+	}
+
+	return projectInfo, nil
+}
+
+// Duplicate creates a new project of the given name whose contents are copied
+// from the given project. All IDs of the copy are renewed, and the new project
+// records the project that it is forked from. If projectName is empty, the
+// name of the source project is used with a suffix.
+func Duplicate(
+	ctx context.Context,
+	db database.Database,
+	yorkieConf *yorkie.Config,
+	id types.ID,
+	projectName string,
+	organizationID types.ID,
+) (*types.ProjectInfo, error) {
+	sourceInfo, err := db.FindProject(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if err := cli.Close(); err != nil {
-			log.Logger.Error(err)
-		}
-	}()
+	source, err := readDocument(ctx, yorkieConf, id)
+	if err != nil {
+		return nil, err
+	}
+	if projectName == "" {
+		projectName = sourceInfo.Name + " (copy)"
+	}
 
-	if err := cli.Activate(ctx); err != nil {
+	projectInfo, err := db.CreateProject(ctx, projectName, organizationID, id)
+	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if err := cli.Deactivate(ctx); err != nil {
-			log.Logger.Error(err)
+
+	// The project read from the document does not share anything with the
+	// document, so renewing its IDs makes a deep copy.
+	source.RenewIDs()
+	source.ID = projectInfo.ID.String()
+	source.Name = projectInfo.Name
+	if err := withDocument(ctx, yorkieConf, projectInfo.ID, func(doc *document.Document) error {
+		return updateProject(doc, source)
+	}); err != nil {
+		// The new project is removed so that it is not left without contents.
+		if removeErr := db.RemoveProject(ctx, projectInfo.ID); removeErr != nil {
+			log.Logger.Errorf("remove project %s: %s", projectInfo.ID, removeErr.Error())
 		}
-	}()
+		return nil, err
+	}
 
-	doc := document.New(yorkieConf.Collection, projectInfo.ID.String())
-	if err := cli.Attach(ctx, doc); err != nil {
+	return projectInfo, nil
+}
+
+// Read returns the contents of the given project read from its document.
+func Read(
+	ctx context.Context,
+	db database.Database,
+	yorkieConf *yorkie.Config,
+	id types.ID,
+) (*types.Project, error) {
+	// FindProject checks that the user can access the project.
+	if _, err := db.FindProject(ctx, id); err != nil {
 		return nil, err
 	}
 
-	project := types.NewProject(projectInfo.ID.String(), projectInfo.Name)
-	if err := updateProject(doc, project); err != nil {
+	return readDocument(ctx, yorkieConf, id)
+}
+
+// readDocument returns the contents of the given project read from its
+// document without checking that the user can access the project.
+func readDocument(ctx context.Context, yorkieConf *yorkie.Config, id types.ID) (*types.Project, error) {
+	var project *types.Project
+	if err := withDocument(ctx, yorkieConf, id, func(doc *document.Document) error {
+		var err error
+		project, err = toProject(doc)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// SaveAsTemplate creates a new template from the contents of the given
+// project. If stripPositions is true, the positions of blocks are not saved.
+func SaveAsTemplate(
+	ctx context.Context,
+	db database.Database,
+	yorkieConf *yorkie.Config,
+	id types.ID,
+	templateName string,
+	public bool,
+	stripPositions bool,
+) (*types.TemplateInfo, error) {
+	project, err := Read(ctx, db, yorkieConf, id)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := cli.Detach(ctx, doc); err != nil {
+	project.ID = ""
+	project.Name = templateName
+	if stripPositions {
+		project.StripPositions()
+	}
+
+	contents, err := json.Marshal(project)
+	if err != nil {
 		return nil, err
 	}
 
-	return projectInfo, nil
+	return templates.Create(ctx, db, templateName, string(contents), public)
+}
+
+// ClearDocument removes the contents of the document of the given project. It
+// is used to clean up the documents of purged projects.
+func ClearDocument(ctx context.Context, yorkieConf *yorkie.Config, id types.ID) error {
+	return withDocument(ctx, yorkieConf, id, func(doc *document.Document) error {
+		return doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Delete("project")
+			return nil
+		})
+	})
+}
+
+// RoleOf returns the role of the user in the given project. The role given
+// through the organization of the project is also considered, and the higher
+// role is returned. It returns false if the user cannot access the project.
+func RoleOf(
+	ctx context.Context,
+	db database.Database,
+	project *types.ProjectInfo,
+) (types.Role, bool, error) {
+	userID := types.UserIDFromCtx(ctx)
+	role, ok := project.RoleOf(userID)
+	if role == types.RoleOwner || project.OrganizationID == "" {
+		return role, ok, nil
+	}
+
+	organization, err := db.FindOrganization(ctx, project.OrganizationID)
+	if errors.Is(err, database.ErrNotFound) {
+		return role, ok, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	orgRole, _ := organization.RoleOf(userID)
+	if !ok || !role.Covers(orgRole.ProjectRole()) {
+		return orgRole.ProjectRole(), true, nil
+	}
+	return role, ok, nil
 }
 
 func updateProject(doc *document.Document, p *types.Project) error {
@@ -94,22 +231,17 @@ func updateProject(doc *document.Document, p *types.Project) error {
 			network.SetString("id", n.ID)
 			network.SetString("name", n.Name)
 			dependencies := network.SetNewObject("dependencies")
-			thirdPartyDeps := dependencies.SetNewObject("thirdPartyDeps")
 			blocks := network.SetNewObject("blocks")
 			links := network.SetNewObject("links")
 
 			// dependencies
-			for dID, d := range n.Dependencies.ThirdPartyDeps {
-				dependency := thirdPartyDeps.SetNewObject(dID)
-				dependency.SetString("id", d.ID)
-				dependency.SetString("name", d.Name)
-				if d.Alias != "" {
-					dependency.SetString("alias", d.Alias)
-				}
-				if d.Package != "" {
-					dependency.SetString("package", d.Package)
-				}
+			deps := n.Dependencies
+			if deps == nil {
+				deps = &types.Dependencies{}
 			}
+			updateDependencies(dependencies.SetNewObject("builtInDeps"), deps.BuiltInDeps)
+			updateDependencies(dependencies.SetNewObject("thirdPartyDeps"), deps.ThirdPartyDeps)
+			updateDependencies(dependencies.SetNewObject("projectDeps"), deps.ProjectDeps)
 
 			// blocks
 			for bID, b := range n.Blocks {
@@ -117,19 +249,27 @@ func updateProject(doc *document.Document, p *types.Project) error {
 				block.SetString("id", b.ID)
 				block.SetString("name", b.Name)
 				block.SetString("type", string(b.Type))
+				pos := b.Position
+				if pos == nil {
+					pos = &types.Position{}
+				}
 				position := block.SetNewObject("position")
-				position.SetInteger("x", b.Position.X)
-				position.SetInteger("y", b.Position.Y)
+				position.SetInteger("x", pos.X)
+				position.SetInteger("y", pos.Y)
 
 				if b.Type == types.InType {
 					block.SetString("initVariables", b.InitVariables)
 				} else if b.Type == types.NetworkType {
 					block.SetString("refNetwork", b.RefNetwork)
 					block.SetInteger("repeats", b.Repeats)
-					updateParameters(block, b.Parameters)
+					if err := updateParameters(block, b.Type, b.Parameters); err != nil {
+						return err
+					}
 				} else {
 					block.SetInteger("repeats", b.Repeats)
-					updateParameters(block, b.Parameters)
+					if err := updateParameters(block, b.Type, b.Parameters); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -146,18 +286,58 @@ func updateProject(doc *document.Document, p *types.Project) error {
 	})
 }
 
-func updateParameters(block *proxy.ObjectProxy, params types.Parameters) {
+func updateDependencies(obj *proxy.ObjectProxy, deps map[string]*types.Dependency) {
+	for dID, d := range deps {
+		dependency := obj.SetNewObject(dID)
+		dependency.SetString("id", d.ID)
+		dependency.SetString("name", d.Name)
+		if d.Alias != "" {
+			dependency.SetString("alias", d.Alias)
+		}
+		if d.Package != "" {
+			dependency.SetString("package", d.Package)
+		}
+	}
+}
+
+// updateParameters sets the given parameters to the block. Values are converted
+// to the types declared in the registry of block types if possible. Values
+// that cannot be converted are set as they are, except that integral numbers
+// are set as integers because numbers decoded from JSON are always float64.
+func updateParameters(block *proxy.ObjectProxy, blockType types.BlockType, params types.Parameters) error {
+	spec, _ := types.FindBlockSpec(blockType)
+
 	parameters := block.SetNewObject("parameters")
 	for pID, p := range params {
+		converted := false
+		if spec != nil {
+			if param, ok := spec.Parameter(pID); ok {
+				if v, err := param.Convert(p); err == nil {
+					p = v
+					converted = true
+				}
+			}
+		}
+
 		switch v := p.(type) {
 		case string:
 			parameters.SetString(pID, v)
 		case int:
 			parameters.SetInteger(pID, v)
+		case int64:
+			parameters.SetLong(pID, v)
+		case float64:
+			if !converted && v == math.Trunc(v) {
+				parameters.SetInteger(pID, int(v))
+			} else {
+				parameters.SetDouble(pID, v)
+			}
 		case bool:
 			parameters.SetBool(pID, v)
 		default:
-			log.Logger.Fatal("unsupported type")
+			return fmt.Errorf("%s: %w", pID, ErrUnsupportedParameter)
 		}
 	}
+
+	return nil
 }
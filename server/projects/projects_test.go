@@ -0,0 +1,69 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projects
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestUpdateParameters(t *testing.T) {
+	doc := document.New("projects", t.Name())
+	err := doc.Update(func(root *proxy.ObjectProxy) error {
+		if err := updateParameters(root.SetNewObject("conv"), types.Conv2dType, types.Parameters{
+			"kernel_size": 3.0,
+			"unknown":     2.0,
+		}); err != nil {
+			return err
+		}
+		return updateParameters(root.SetNewObject("bn"), types.BatchNorm2dType, types.Parameters{
+			"momentum": 1.0,
+		})
+	})
+	assert.NoError(t, err)
+
+	// integral numbers are set as integers unless the spec declares float.
+	assert.Equal(
+		t,
+		`{"bn":{"parameters":{"momentum":1.000000}},"conv":{"parameters":{"kernel_size":3,"unknown":2}}}`,
+		doc.Marshal(),
+	)
+}
+
+func TestToProject(t *testing.T) {
+	t.Run("cleared document test", func(t *testing.T) {
+		doc := document.New("projects", t.Name())
+		_, err := toProject(doc)
+		assert.True(t, errors.Is(err, ErrInvalidDocument))
+	})
+
+	t.Run("malformed document test", func(t *testing.T) {
+		doc := document.New("projects", t.Name())
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("project").SetString("networks", "malformed")
+			return nil
+		}))
+		_, err := toProject(doc)
+		assert.True(t, errors.Is(err, ErrInvalidDocument))
+	})
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package purge permanently removes the projects that were deleted before the
+// retention period, along with the contents of their documents.
+package purge
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/metis-labs/metis-server/internal/log"
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/projects"
+	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/yorkie"
+)
+
+// Config is the configuration for creating a Worker.
+type Config struct {
+	// RetentionPeriod is how long deleted projects are kept to be restored.
+	RetentionPeriod time.Duration `json:"RetentionPeriod"`
+
+	// Interval is the interval of purging. If it is zero, the worker does
+	// not run.
+	Interval time.Duration `json:"Interval"`
+}
+
+// Purge permanently removes the projects deleted before the given retention
+// period and clears their documents, and returns the removed projects. If
+// dryRun is true, the projects to be removed are returned without removing
+// them.
+func Purge(
+	ctx context.Context,
+	db database.Database,
+	yorkieConf *yorkie.Config,
+	retentionPeriod time.Duration,
+	dryRun bool,
+) ([]*types.ProjectInfo, error) {
+	deletedBefore := time.Now().Add(-retentionPeriod)
+	projectInfos, err := db.ListProjectsDeletedBefore(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return projectInfos, nil
+	}
+
+	var purged []*types.ProjectInfo
+	for _, info := range projectInfos {
+		// The project is claimed before its document is cleared, so that it
+		// cannot be restored with the cleared document. A claimed project
+		// stays claimed if clearing fails, and is retried in the next run.
+		if err := db.ClaimProjectForPurge(ctx, info.ID, deletedBefore); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				log.Logger.Infof("purge: skip project %s restored or purged meanwhile", info.ID)
+				continue
+			}
+			return purged, err
+		}
+		if err := projects.ClearDocument(ctx, yorkieConf, info.ID); err != nil {
+			log.Logger.Warnf("purge: clear document of %s: %s", info.ID, err.Error())
+			continue
+		}
+		if err := db.PurgeProject(ctx, info.ID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				log.Logger.Infof("purge: skip project %s purged meanwhile", info.ID)
+				continue
+			}
+			return purged, err
+		}
+
+		log.Logger.Infof(
+			"purge: removed project %s %q of %s deleted at %s",
+			info.ID, info.Name, info.Owner, info.DeletedAt.Format(time.RFC3339),
+		)
+		purged = append(purged, info)
+	}
+
+	return purged, nil
+}
+
+// Worker purges deleted projects periodically.
+type Worker struct {
+	conf       *Config
+	db         database.Database
+	yorkieConf *yorkie.Config
+
+	cancel context.CancelFunc
+	doneCh chan struct{}
+}
+
+// NewWorker creates a new instance of Worker.
+func NewWorker(conf *Config, db database.Database, yorkieConf *yorkie.Config) *Worker {
+	return &Worker{
+		conf:       conf,
+		db:         db,
+		yorkieConf: yorkieConf,
+	}
+}
+
+// Start starts purging in the background.
+func (w *Worker) Start() {
+	if w.conf.Interval <= 0 {
+		log.Logger.Info("purge: worker is disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.doneCh = make(chan struct{})
+
+	go w.run(ctx)
+
+	log.Logger.Infof(
+		"purge: worker is running every %s with retention period %s",
+		w.conf.Interval, w.conf.RetentionPeriod,
+	)
+}
+
+// Stop stops purging and waits until the running purge is finished.
+func (w *Worker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+
+	w.cancel()
+	<-w.doneCh
+	w.cancel = nil
+}
+
+func (w *Worker) run(ctx context.Context) {
+	defer close(w.doneCh)
+
+	ticker := time.NewTicker(w.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := Purge(ctx, w.db, w.yorkieConf, w.conf.RetentionPeriod, false)
+			if err != nil && ctx.Err() == nil {
+				log.Logger.Errorf("purge: %s", err.Error())
+			}
+			if len(purged) > 0 {
+				log.Logger.Infof("purge: removed %d projects", len(purged))
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/metis-labs/metis-server/api"
+	"github.com/metis-labs/metis-server/api/converter"
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/yorkie"
+)
+
+// adminServer processes the requests of the operators of the server. The
+// policy of methods allows only the admins to call its methods.
+type adminServer struct {
+	pb.UnimplementedMetisAdminServer
+
+	db         database.Database
+	yorkieConf *yorkie.Config
+}
+
+// ListProjects returns a page of the projects of all users that match the
+// given filter.
+func (s *adminServer) ListProjects(
+	ctx context.Context,
+	req *pb.AdminListProjectsRequest,
+) (*pb.AdminListProjectsResponse, error) {
+	projectInfos, nextPageToken, err := s.db.ListAllProjects(ctx, &database.ProjectFilter{
+		Owner:     req.Owner,
+		Status:    req.Status,
+		Name:      req.Name,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AdminListProjectsResponse{
+		Projects:      converter.ToProjects(projectInfos, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// DeleteProject deletes the given project regardless of its owner.
+func (s *adminServer) DeleteProject(
+	ctx context.Context,
+	req *pb.AdminDeleteProjectRequest,
+) (*pb.AdminDeleteProjectResponse, error) {
+	if err := s.db.ForceDeleteProject(ctx, types.ID(req.ProjectId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.AdminDeleteProjectResponse{}, nil
+}
+
+// RestoreProject restores the given deleted project regardless of its owner.
+func (s *adminServer) RestoreProject(
+	ctx context.Context,
+	req *pb.AdminRestoreProjectRequest,
+) (*pb.AdminRestoreProjectResponse, error) {
+	if err := s.db.ForceRestoreProject(ctx, types.ID(req.ProjectId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.AdminRestoreProjectResponse{}, nil
+}
+
+// TransferProject changes the owner of the given project.
+func (s *adminServer) TransferProject(
+	ctx context.Context,
+	req *pb.AdminTransferProjectRequest,
+) (*pb.AdminTransferProjectResponse, error) {
+	if err := s.db.ForceTransferProject(ctx, types.ID(req.ProjectId), req.NewOwner); err != nil {
+		return nil, err
+	}
+
+	return &pb.AdminTransferProjectResponse{}, nil
+}
+
+// GetSystemStats returns the statistics of the server.
+func (s *adminServer) GetSystemStats(
+	ctx context.Context,
+	req *pb.GetSystemStatsRequest,
+) (*pb.GetSystemStatsResponse, error) {
+	counts, err := s.db.CountProjectsByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetSystemStatsResponse{
+		ProjectsByStatus: make(map[string]int64),
+	}
+	for projectStatus, count := range counts {
+		res.TotalProjects += int64(count)
+		res.ProjectsByStatus[projectStatus] = int64(count)
+	}
+
+	return res, nil
+}
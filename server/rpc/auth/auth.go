@@ -0,0 +1,101 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package auth provides authenticators that verify the tokens sent by clients
+// and return the IDs of their users.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultUserIDClaim is the claim of the token that holds the user ID if it
+// is not configured.
+const DefaultUserIDClaim = "sub"
+
+var (
+	// ErrUnauthenticated is returned when the token cannot be verified.
+	ErrUnauthenticated = errors.New("unauthenticated")
+
+	// ErrNoKeys is returned when no keys are configured to verify tokens and
+	// the insecure mode is not enabled.
+	ErrNoKeys = errors.New("no keys to verify tokens")
+)
+
+// Config is the configuration for creating an Authenticator.
+type Config struct {
+	// InsecureDev accepts the token as the user ID itself without verifying
+	// it. It must be used only for development and tests.
+	InsecureDev bool `json:"InsecureDev"`
+
+	// HMACSecret is the secret to verify HS256 tokens.
+	HMACSecret string `json:"HMACSecret"`
+
+	// RSAPublicKeyFile is the path of the PEM encoded public key to verify
+	// RS256 tokens.
+	RSAPublicKeyFile string `json:"RSAPublicKeyFile"`
+
+	// JWKSFile is the path of the JSON Web Key Set of the public keys to
+	// verify RS256 tokens.
+	JWKSFile string `json:"JWKSFile"`
+
+	// UserIDClaim is the claim of the token that holds the user ID.
+	UserIDClaim string `json:"UserIDClaim"`
+
+	// Issuer and Audience are checked against the claims of the token if
+	// they are given.
+	Issuer   string `json:"Issuer"`
+	Audience string `json:"Audience"`
+}
+
+// Authenticator verifies the token sent by the client and returns the ID of
+// its user.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// New creates an Authenticator of the given configuration.
+func New(conf *Config) (Authenticator, error) {
+	if conf.InsecureDev {
+		return &insecureAuthenticator{}, nil
+	}
+
+	return newJWTAuthenticator(conf)
+}
+
+// insecureAuthenticator accepts the token as the user ID.
+type insecureAuthenticator struct{}
+
+// Authenticate returns the given token as the user ID.
+func (a *insecureAuthenticator) Authenticate(_ context.Context, token string) (string, error) {
+	userID := trimBearer(token)
+	if userID == "" {
+		return "", fmt.Errorf("empty token: %w", ErrUnauthenticated)
+	}
+
+	return userID, nil
+}
+
+// trimBearer removes the "Bearer" scheme from the given authorization value.
+func trimBearer(token string) string {
+	if len(token) > 7 && strings.EqualFold(token[:7], "bearer ") {
+		return strings.TrimSpace(token[7:])
+	}
+	return strings.TrimSpace(token)
+}
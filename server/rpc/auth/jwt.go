@@ -0,0 +1,181 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// jwtAuthenticator verifies HS256 and RS256 JSON Web Tokens.
+type jwtAuthenticator struct {
+	conf       *Config
+	hmacSecret []byte
+
+	// rsaKeys are the public keys by their key IDs. The key loaded from the
+	// PEM file has the empty key ID.
+	rsaKeys map[string]*rsa.PublicKey
+	parser  *jwt.Parser
+}
+
+func newJWTAuthenticator(conf *Config) (*jwtAuthenticator, error) {
+	a := &jwtAuthenticator{
+		conf:    conf,
+		rsaKeys: make(map[string]*rsa.PublicKey),
+		parser:  jwt.NewParser(jwt.WithValidMethods([]string{"HS256", "RS256"})),
+	}
+
+	if conf.HMACSecret != "" {
+		a.hmacSecret = []byte(conf.HMACSecret)
+	}
+
+	if conf.RSAPublicKeyFile != "" {
+		data, err := ioutil.ReadFile(conf.RSAPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", conf.RSAPublicKeyFile, err)
+		}
+		a.rsaKeys[""] = key
+	}
+
+	if conf.JWKSFile != "" {
+		keys, err := loadJWKS(conf.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", conf.JWKSFile, err)
+		}
+		for kid, key := range keys {
+			a.rsaKeys[kid] = key
+		}
+	}
+
+	if a.hmacSecret == nil && len(a.rsaKeys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return a, nil
+}
+
+// Authenticate verifies the given token and returns the user ID in its claim.
+func (a *jwtAuthenticator) Authenticate(_ context.Context, token string) (string, error) {
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(trimBearer(token), claims, a.key); err != nil {
+		return "", fmt.Errorf("%s: %w", err.Error(), ErrUnauthenticated)
+	}
+
+	// The parser only checks exp if the token has it, so tokens without it
+	// are rejected here to prevent them from being valid forever.
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return "", fmt.Errorf("missing or expired exp: %w", ErrUnauthenticated)
+	}
+	if a.conf.Issuer != "" && !claims.VerifyIssuer(a.conf.Issuer, true) {
+		return "", fmt.Errorf("invalid issuer: %w", ErrUnauthenticated)
+	}
+	if a.conf.Audience != "" && !claims.VerifyAudience(a.conf.Audience, true) {
+		return "", fmt.Errorf("invalid audience: %w", ErrUnauthenticated)
+	}
+
+	claim := a.conf.UserIDClaim
+	if claim == "" {
+		claim = DefaultUserIDClaim
+	}
+	userID, ok := claims[claim].(string)
+	if !ok || userID == "" {
+		return "", fmt.Errorf("missing claim %q: %w", claim, ErrUnauthenticated)
+	}
+
+	return userID, nil
+}
+
+// key returns the key to verify the given token by its algorithm and key ID.
+func (a *jwtAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case "HS256":
+		if a.hmacSecret == nil {
+			return nil, fmt.Errorf("HS256 is not configured")
+		}
+		return a.hmacSecret, nil
+	case "RS256":
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := a.rsaKeys[kid]; ok {
+			return key, nil
+		}
+		// Tokens without key ID can be verified if only one key is configured.
+		if kid == "" && len(a.rsaKeys) == 1 {
+			for _, key := range a.rsaKeys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+
+	return nil, fmt.Errorf("unsupported algorithm %q", token.Method.Alg())
+}
+
+// loadJWKS loads the RSA public keys from the JSON Web Key Set file.
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,248 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/server/rpc/auth"
+)
+
+const (
+	testUserA = "KR18401"
+	testUserB = "KR18817"
+)
+
+func TestAuth(t *testing.T) {
+	t.Run("no keys test", func(t *testing.T) {
+		_, err := auth.New(&auth.Config{})
+		assert.True(t, errors.Is(err, auth.ErrNoKeys))
+	})
+
+	t.Run("insecure dev test", func(t *testing.T) {
+		authenticator, err := auth.New(&auth.Config{InsecureDev: true})
+		assert.NoError(t, err)
+
+		userID, err := authenticator.Authenticate(context.Background(), testUserA)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserA, userID)
+	})
+
+	t.Run("HS256 test", func(t *testing.T) {
+		ctx := context.Background()
+		authenticator, err := auth.New(&auth.Config{
+			HMACSecret:  "secret",
+			UserIDClaim: "employee_id",
+			Issuer:      "metis",
+		})
+		assert.NoError(t, err)
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"employee_id": testUserA,
+			"iss":         "metis",
+			"exp":         time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		userID, err := authenticator.Authenticate(ctx, "Bearer "+token)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserA, userID)
+
+		expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"employee_id": testUserA,
+			"iss":         "metis",
+			"exp":         time.Now().Add(-time.Hour).Unix(),
+		}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, expired)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+
+		forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"employee_id": testUserA,
+			"iss":         "metis",
+		}).SignedString([]byte("forged"))
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, forged)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+
+		noExp, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"employee_id": testUserA,
+			"iss":         "metis",
+		}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, noExp)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+
+		otherIssuer, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"employee_id": testUserA,
+			"iss":         "other",
+			"exp":         time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, otherIssuer)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+
+		_, err = authenticator.Authenticate(ctx, testUserA)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+	})
+
+	t.Run("audience test", func(t *testing.T) {
+		ctx := context.Background()
+		authenticator, err := auth.New(&auth.Config{
+			HMACSecret: "secret",
+			Audience:   "metis",
+		})
+		assert.NoError(t, err)
+
+		for _, tc := range []struct {
+			audience interface{}
+			valid    bool
+		}{
+			{"metis", true},
+			{[]string{"other", "metis"}, true},
+			{"other", false},
+			{nil, false},
+		} {
+			claims := jwt.MapClaims{
+				"sub": testUserA,
+				"exp": time.Now().Add(time.Hour).Unix(),
+			}
+			if tc.audience != nil {
+				claims["aud"] = tc.audience
+			}
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+			assert.NoError(t, err)
+
+			userID, err := authenticator.Authenticate(ctx, token)
+			if tc.valid {
+				assert.NoError(t, err, "aud: %v", tc.audience)
+				assert.Equal(t, testUserA, userID)
+			} else {
+				assert.True(t, errors.Is(err, auth.ErrUnauthenticated), "aud: %v", tc.audience)
+			}
+		}
+	})
+
+	t.Run("RS256 with public key file test", func(t *testing.T) {
+		ctx := context.Background()
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		assert.NoError(t, err)
+		path := writeTempFile(t, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+		authenticator, err := auth.New(&auth.Config{RSAPublicKeyFile: path})
+		assert.NoError(t, err)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": testUserB,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+		userID, err := authenticator.Authenticate(ctx, signed)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserB, userID)
+
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+		signed, err = token.SignedString(other)
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, signed)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+
+		// HS256 is not accepted if only the public key is configured.
+		hs256, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": testUserB,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, hs256)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+
+		_, err = auth.New(&auth.Config{RSAPublicKeyFile: writeTempFile(t, []byte("invalid"))})
+		assert.Error(t, err)
+	})
+
+	t.Run("RS256 with JWKS test", func(t *testing.T) {
+		ctx := context.Background()
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(t, err)
+
+		jwks, err := json.Marshal(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "key-1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+		assert.NoError(t, err)
+
+		authenticator, err := auth.New(&auth.Config{JWKSFile: writeTempFile(t, jwks)})
+		assert.NoError(t, err)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": testUserB,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = "key-1"
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+		userID, err := authenticator.Authenticate(ctx, signed)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserB, userID)
+
+		token.Header["kid"] = "key-2"
+		signed, err = token.SignedString(key)
+		assert.NoError(t, err)
+		_, err = authenticator.Authenticate(ctx, signed)
+		assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+	})
+}
+
+// writeTempFile writes the given data to a temporary file that is removed
+// after the test, and returns the path of the file.
+func writeTempFile(t *testing.T, data []byte) string {
+	file, err := ioutil.TempFile("", "auth")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, os.Remove(file.Name()))
+	})
+
+	_, err = file.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	return file.Name()
+}
@@ -19,75 +19,181 @@ package rpc
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/metis-labs/metis-server/api/converter"
 	"github.com/metis-labs/metis-server/internal/log"
+	"github.com/metis-labs/metis-server/server/apikeys"
+	"github.com/metis-labs/metis-server/server/codegen"
 	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/invites"
+	"github.com/metis-labs/metis-server/server/projects"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
+	"github.com/metis-labs/metis-server/server/templates"
 	"github.com/metis-labs/metis-server/server/types"
 )
 
+// authorizer authorizes the requests according to the policy of methods.
+type authorizer struct {
+	authenticator          auth.Authenticator
+	db                     database.Database
+	admins                 map[string]bool
+	apiKeyLastUsedInterval time.Duration
+}
+
+// newAuthorizer creates a new authorizer with the given admin users.
+func newAuthorizer(
+	authenticator auth.Authenticator,
+	db database.Database,
+	adminUserIDs []string,
+	apiKeyLastUsedInterval time.Duration,
+) *authorizer {
+	admins := make(map[string]bool)
+	for _, userID := range adminUserIDs {
+		admins[userID] = true
+	}
+
+	return &authorizer{
+		authenticator:          authenticator,
+		db:                     db,
+		admins:                 admins,
+		apiKeyLastUsedInterval: apiKeyLastUsedInterval,
+	}
+}
+
 func unaryInterceptor(
+	authz *authorizer,
+) func(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	start := time.Now()
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
 
-	// TODO(hackerwins): do authenticate only against authMethods
-	ctx, err := authenticate(ctx)
-	if err != nil {
-		return nil, err
-	}
+		ctx, err := authz.authorize(ctx, info.FullMethod)
+		if err != nil {
+			log.Logger.Warnf("RPC : %q %s: %q", info.FullMethod, time.Since(start), err)
+			return nil, err
+		}
 
-	resp, err := handler(ctx, req)
-	if err == nil {
-		log.Logger.Infof("RPC : %q %s", info.FullMethod, time.Since(start))
-	} else {
-		err = toStatusError(err)
-		log.Logger.Warnf("RPC : %q %s: %q => %q", info.FullMethod, time.Since(start), req, err)
-	}
+		resp, err := handler(ctx, req)
+		if err == nil {
+			log.Logger.Infof("RPC : %q %s", info.FullMethod, time.Since(start))
+		} else {
+			err = toStatusError(err)
+			log.Logger.Warnf("RPC : %q %s: %q => %q", info.FullMethod, time.Since(start), req, err)
+		}
 
-	return resp, err
+		return resp, err
+	}
 }
 
 func streamInterceptor(
+	authz *authorizer,
+) func(
 	srv interface{},
 	ss grpc.ServerStream,
 	info *grpc.StreamServerInfo,
 	handler grpc.StreamHandler,
 ) error {
-	err := handler(srv, ss)
-	if err == nil {
-		log.Logger.Infof("stream %q => ok", info.FullMethod)
-	} else {
-		log.Logger.Warn("stream %q => %s", info.FullMethod, err.Error())
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authz.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			log.Logger.Warnf("stream %q => %s", info.FullMethod, err.Error())
+			return err
+		}
+
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		err = handler(srv, wrapped)
+		if err == nil {
+			log.Logger.Infof("stream %q => ok", info.FullMethod)
+		} else {
+			err = toStatusError(err)
+			log.Logger.Warnf("stream %q => %s", info.FullMethod, err.Error())
+		}
+
+		return err
 	}
+}
 
-	return err
+// authorize authenticates the user of the request and checks that the user
+// can call the given method. The token is either a user token verified by the
+// authenticator or an API key, and the scope of the API key is checked against
+// the given method.
+func (a *authorizer) authorize(ctx context.Context, method string) (context.Context, error) {
+	level, ok := accessOf(method)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s has no access policy", method)
+	}
+	if level == accessPublic {
+		return ctx, nil
+	}
+
+	token, err := tokenOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key := strings.TrimPrefix(token, "Bearer "); apikeys.IsAPIKey(key) {
+		info, err := apikeys.Verify(ctx, a.db, key, a.apiKeyLastUsedInterval)
+		if errors.Is(err, auth.ErrUnauthenticated) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !allowedByScope(info.Scope, level) {
+			return nil, status.Errorf(codes.PermissionDenied, "api key of %s scope cannot call %s", info.Scope, method)
+		}
+
+		return types.CtxWithUserID(ctx, info.Owner), nil
+	}
+
+	userID, err := a.authenticator.Authenticate(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if level == accessAdmin && !a.admins[userID] {
+		return nil, status.Errorf(codes.PermissionDenied, "%s requires %s access", method, level)
+	}
+
+	return types.CtxWithUserID(ctx, userID), nil
 }
 
-func authenticate(ctx context.Context) (context.Context, error) {
+// tokenOf returns the authorization token in the metadata of the request.
+func tokenOf(ctx context.Context) (string, error) {
 	data, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+		return "", status.Errorf(codes.Unauthenticated, "metadata is not provided")
 	}
 
 	values := data["authorization"]
-	if len(values) == 0 {
-		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
-	}
-	userID := values[0]
-	if len(userID) == 0 {
-		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	if len(values) == 0 || len(values[0]) == 0 {
+		return "", status.Errorf(codes.Unauthenticated, "authorization token is not provided")
 	}
 
-	return types.CtxWithUserID(ctx, userID), nil
+	return values[0], nil
 }
 
 // toStatusError returns a status.Error from the given logic error. If an error
@@ -97,9 +203,28 @@ func toStatusError(err error) error {
 	if errors.Is(err, database.ErrNotFound) {
 		return status.Error(codes.NotFound, err.Error())
 	}
-	if errors.Is(err, database.ErrInvalidID) {
+	if errors.Is(err, database.ErrInvalidID) ||
+		errors.Is(err, database.ErrInvalidScope) ||
+		errors.Is(err, database.ErrInvalidPageToken) ||
+		errors.Is(err, database.ErrInvalidSortBy) ||
+		errors.Is(err, database.ErrInvalidOwner) ||
+		errors.Is(err, templates.ErrInvalidTemplate) ||
+		errors.Is(err, projects.ErrUnsupportedParameter) ||
+		errors.Is(err, types.ErrInvalidRole) ||
+		errors.Is(err, types.ErrInvalidParameter) ||
+		errors.Is(err, types.ErrMissingParameter) ||
+		errors.Is(err, invites.ErrInvalidInvite) ||
+		errors.Is(err, apikeys.ErrInvalidAPIKey) ||
+		errors.Is(err, converter.ErrInvalidUpdateMask) {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
+	if errors.Is(err, types.ErrCycleDetected) ||
+		errors.Is(err, types.ErrRecursiveNetwork) ||
+		errors.Is(err, codegen.ErrUnsupportedBlockType) ||
+		errors.Is(err, codegen.ErrMissingNetwork) ||
+		errors.Is(err, projects.ErrInvalidDocument) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
 
 	return status.Error(codes.Internal, err.Error())
 }
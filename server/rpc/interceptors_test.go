@@ -0,0 +1,43 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metis-labs/metis-server/server/projects"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestToStatusError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("stride: %w", types.ErrInvalidParameter), codes.InvalidArgument},
+		{fmt.Errorf("kernel_size: %w", types.ErrMissingParameter), codes.InvalidArgument},
+		{fmt.Errorf("project: %w", projects.ErrInvalidDocument), codes.FailedPrecondition},
+		{fmt.Errorf("unknown"), codes.Internal},
+	} {
+		assert.Equal(t, tc.code, status.Code(toStatusError(tc.err)), tc.err.Error())
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import "strings"
+
+// access is the level of access required to call a method.
+type access int
+
+// Belows are the levels of access.
+const (
+	// accessPublic does not require any token.
+	accessPublic access = iota
+
+	// accessRead requires the token of a user or an API key of any scope. It
+	// is for the methods that only read data.
+	accessRead
+
+	// accessWrite requires the token of a user or an API key of the read_write
+	// scope.
+	accessWrite
+
+	// accessUser requires the token of a user. API keys cannot be used for
+	// these methods, so that a leaked key cannot be used to issue new keys.
+	accessUser
+
+	// accessAdmin requires the token of a user in the admins of the server.
+	// API keys cannot be used for these methods.
+	accessAdmin
+)
+
+// adminServicePrefix is the prefix of the full method names of the admin
+// service. Its methods require accessAdmin even if they are not in
+// methodAccess.
+const adminServicePrefix = "/api.MetisAdmin/"
+
+// String returns the string representation of this access.
+func (a access) String() string {
+	switch a {
+	case accessPublic:
+		return "public"
+	case accessRead:
+		return "read"
+	case accessWrite:
+		return "write"
+	case accessUser:
+		return "user"
+	}
+	return "admin"
+}
+
+// methodAccess is the policy that maps the full method names of gRPC to the
+// levels of access required to call them. Every method must be listed, and
+// methods that are not listed cannot be called. The level of a method of Metis
+// also decides which scopes of API keys can call it.
+var methodAccess = map[string]access{
+	"/grpc.health.v1.Health/Check": accessPublic,
+	"/grpc.health.v1.Health/Watch": accessPublic,
+
+	"/api.Metis/CreateProject":       accessWrite,
+	"/api.Metis/ListProjects":        accessRead,
+	"/api.Metis/GetProject":          accessRead,
+	"/api.Metis/UpdateProject":       accessWrite,
+	"/api.Metis/DeleteProject":       accessWrite,
+	"/api.Metis/ListDeletedProjects": accessRead,
+	"/api.Metis/RestoreProject":      accessWrite,
+	"/api.Metis/GetProjectContents":  accessRead,
+	"/api.Metis/DuplicateProject":    accessWrite,
+	"/api.Metis/TransferProject":     accessWrite,
+
+	"/api.Metis/AddCollaborator":    accessWrite,
+	"/api.Metis/RemoveCollaborator": accessWrite,
+	"/api.Metis/ListCollaborators":  accessRead,
+
+	"/api.Metis/CreateOrganization":       accessWrite,
+	"/api.Metis/ListOrganizations":        accessRead,
+	"/api.Metis/AddOrganizationMember":    accessWrite,
+	"/api.Metis/RemoveOrganizationMember": accessWrite,
+
+	"/api.Metis/CreateInvite": accessWrite,
+	"/api.Metis/AcceptInvite": accessWrite,
+	"/api.Metis/RevokeInvite": accessWrite,
+	"/api.Metis/ListInvites":  accessRead,
+
+	"/api.Metis/CreateAPIKey": accessUser,
+	"/api.Metis/ListAPIKeys":  accessUser,
+	"/api.Metis/RevokeAPIKey": accessUser,
+
+	"/api.Metis/CreateTemplate":        accessWrite,
+	"/api.Metis/ListTemplates":         accessRead,
+	"/api.Metis/GetTemplate":           accessRead,
+	"/api.Metis/UpdateTemplate":        accessWrite,
+	"/api.Metis/DeleteTemplate":        accessWrite,
+	"/api.Metis/ListBuiltinTemplates":  accessRead,
+	"/api.Metis/SaveProjectAsTemplate": accessWrite,
+
+	"/api.Metis/GenerateCode":    accessRead,
+	"/api.Metis/ValidateProject": accessRead,
+	"/api.Metis/InferShapes":     accessRead,
+	"/api.Metis/AnalyzeProject":  accessRead,
+
+	"/api.Metis/ListBlockTypes": accessRead,
+
+	"/api.MetisAdmin/ListProjects":    accessAdmin,
+	"/api.MetisAdmin/DeleteProject":   accessAdmin,
+	"/api.MetisAdmin/RestoreProject":  accessAdmin,
+	"/api.MetisAdmin/TransferProject": accessAdmin,
+	"/api.MetisAdmin/GetSystemStats":  accessAdmin,
+}
+
+// accessOf returns the level of access required to call the given method. It
+// returns false if the method has no policy, except for the methods of the
+// admin service which always require accessAdmin.
+func accessOf(method string) (access, bool) {
+	if a, ok := methodAccess[method]; ok {
+		return a, true
+	}
+	if strings.HasPrefix(method, adminServicePrefix) {
+		return accessAdmin, true
+	}
+	return 0, false
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/metis-labs/metis-server/api"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestPolicy(t *testing.T) {
+	t.Run("every method has policy test", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc   grpc.ServiceDesc
+			levels []access
+		}{
+			{pb.Metis_ServiceDesc, []access{accessRead, accessWrite, accessUser}},
+			{pb.MetisAdmin_ServiceDesc, []access{accessAdmin}},
+			{healthpb.Health_ServiceDesc, []access{accessPublic}},
+		} {
+			var methods []string
+			for _, method := range tc.desc.Methods {
+				methods = append(methods, method.MethodName)
+			}
+			for _, stream := range tc.desc.Streams {
+				methods = append(methods, stream.StreamName)
+			}
+
+			for _, method := range methods {
+				fullMethod := fmt.Sprintf("/%s/%s", tc.desc.ServiceName, method)
+				level, ok := methodAccess[fullMethod]
+				assert.True(t, ok, "%s has no access policy", fullMethod)
+				assert.Contains(t, tc.levels, level, "%s has %s access", fullMethod, level)
+			}
+		}
+	})
+
+	t.Run("api key scope test", func(t *testing.T) {
+		assert.True(t, allowedByScope(types.APIKeyRead, methodAccess["/api.Metis/GetProjectContents"]))
+		assert.False(t, allowedByScope(types.APIKeyRead, methodAccess["/api.Metis/UpdateProject"]))
+		assert.True(t, allowedByScope(types.APIKeyReadWrite, methodAccess["/api.Metis/UpdateProject"]))
+
+		for _, level := range []access{accessUser, accessAdmin} {
+			assert.False(t, allowedByScope(types.APIKeyRead, level))
+			assert.False(t, allowedByScope(types.APIKeyReadWrite, level))
+		}
+	})
+
+	t.Run("unknown method test", func(t *testing.T) {
+		_, ok := accessOf("/api.Metis/Unknown")
+		assert.False(t, ok)
+
+		level, ok := accessOf("/api.MetisAdmin/Unknown")
+		assert.True(t, ok)
+		assert.Equal(t, accessAdmin, level)
+	})
+}
@@ -0,0 +1,31 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import "github.com/metis-labs/metis-server/server/types"
+
+// allowedByScope returns whether the API key of the given scope can call the
+// methods of the given level of access.
+func allowedByScope(scope types.APIKeyScope, level access) bool {
+	switch level {
+	case accessRead:
+		return scope == types.APIKeyRead || scope == types.APIKeyReadWrite
+	case accessWrite:
+		return scope == types.APIKeyReadWrite
+	}
+	return false
+}
@@ -18,19 +18,31 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	pb "github.com/metis-labs/metis-server/api"
 	"github.com/metis-labs/metis-server/api/converter"
 	"github.com/metis-labs/metis-server/internal/log"
+	"github.com/metis-labs/metis-server/server/analysis"
+	"github.com/metis-labs/metis-server/server/apikeys"
+	"github.com/metis-labs/metis-server/server/codegen"
 	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/invites"
 	"github.com/metis-labs/metis-server/server/projects"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
+	"github.com/metis-labs/metis-server/server/shapes"
+	"github.com/metis-labs/metis-server/server/templates"
 	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/validation"
 	"github.com/metis-labs/metis-server/server/yorkie"
 )
 
@@ -39,6 +51,14 @@ type Config struct {
 	Port     int
 	CertFile string
 	KeyFile  string
+
+	// AdminUserIDs are the IDs of the users who can call the methods that
+	// require admin access.
+	AdminUserIDs []string
+
+	// APIKeyLastUsedInterval is the minimum interval of recording the time
+	// when the same API key is used.
+	APIKeyLastUsedInterval time.Duration
 }
 
 // Server is a normal server that processes the logic requested by the client.
@@ -52,13 +72,19 @@ type Server struct {
 }
 
 // NewServer creates a new instance of Server.
-func NewServer(conf *Config, yorkieConf *yorkie.Config, db database.Database) (*Server, error) {
+func NewServer(
+	conf *Config,
+	yorkieConf *yorkie.Config,
+	db database.Database,
+	authenticator auth.Authenticator,
+) (*Server, error) {
+	authz := newAuthorizer(authenticator, db, conf.AdminUserIDs, conf.APIKeyLastUsedInterval)
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-			unaryInterceptor,
+			unaryInterceptor(authz),
 		)),
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
-			streamInterceptor,
+			streamInterceptor(authz),
 		)),
 	}
 
@@ -78,6 +104,11 @@ func NewServer(conf *Config, yorkieConf *yorkie.Config, db database.Database) (*
 		grpcServer: grpc.NewServer(opts...),
 	}
 	pb.RegisterMetisServer(rpcServer.grpcServer, rpcServer)
+	pb.RegisterMetisAdminServer(rpcServer.grpcServer, &adminServer{
+		db:         db,
+		yorkieConf: yorkieConf,
+	})
+	healthpb.RegisterHealthServer(rpcServer.grpcServer, health.NewServer())
 
 	return rpcServer, nil
 }
@@ -117,37 +148,79 @@ func (s *Server) CreateProject(
 	ctx context.Context,
 	req *pb.CreateProjectRequest,
 ) (*pb.CreateProjectResponse, error) {
-	project, err := projects.Create(ctx, s.db, s.yorkieConf, req.ProjectName)
+	template, err := templates.Load(ctx, s.db, req.TemplateId, req.BuiltinTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := projects.Create(
+		ctx,
+		s.db,
+		s.yorkieConf,
+		req.ProjectName,
+		types.ID(req.OrganizationId),
+		template,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.CreateProjectResponse{
-		Project: converter.ToProject(project),
+		Project: converter.ToProject(project, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
 	}, nil
 }
 
-// ListProjects returns the list of projects.
+// ListProjects returns a page of the list of projects.
 func (s *Server) ListProjects(
 	ctx context.Context,
 	req *pb.ListProjectsRequest,
 ) (*pb.ListProjectsResponse, error) {
-	projectList, err := s.db.ListProjects(ctx)
+	projectList, nextPageToken, err := s.db.ListProjects(ctx, &database.ListProjectsOptions{
+		Scope:        req.Scope,
+		PageSize:     int(req.PageSize),
+		PageToken:    req.PageToken,
+		SortBy:       req.SortBy,
+		Descending:   req.Descending,
+		NameContains: req.NameContains,
+		Tag:          req.Tag,
+		Starred:      req.Starred,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ListProjectsResponse{
-		Projects: converter.ToProjects(projectList),
+		Projects:      converter.ToProjects(projectList, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-// UpdateProject updates the given project.
+// GetProject returns the metadata of the given project.
+func (s *Server) GetProject(
+	ctx context.Context,
+	req *pb.GetProjectRequest,
+) (*pb.GetProjectResponse, error) {
+	projectInfo, err := s.db.FindProject(ctx, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetProjectResponse{
+		Project: converter.ToProject(projectInfo, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
+	}, nil
+}
+
+// UpdateProject updates the fields of the given project in the update mask.
 func (s *Server) UpdateProject(
 	ctx context.Context,
 	req *pb.UpdateProjectRequest,
 ) (*pb.UpdateProjectResponse, error) {
-	if err := s.db.UpdateProject(ctx, types.ID(req.ProjectId), req.ProjectName); err != nil {
+	update, err := converter.FromUpdateProjectRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.UpdateProject(ctx, types.ID(req.ProjectId), update); err != nil {
 		return nil, err
 	}
 
@@ -165,3 +238,523 @@ func (s *Server) DeleteProject(
 
 	return &pb.DeleteProjectResponse{}, nil
 }
+
+// ListDeletedProjects returns the list of deleted projects that the user can
+// restore.
+func (s *Server) ListDeletedProjects(
+	ctx context.Context,
+	req *pb.ListDeletedProjectsRequest,
+) (*pb.ListDeletedProjectsResponse, error) {
+	projectInfos, err := s.db.ListDeletedProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListDeletedProjectsResponse{
+		Projects: converter.ToProjects(projectInfos, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
+	}, nil
+}
+
+// RestoreProject restores the given deleted project.
+func (s *Server) RestoreProject(
+	ctx context.Context,
+	req *pb.RestoreProjectRequest,
+) (*pb.RestoreProjectResponse, error) {
+	if err := s.db.RestoreProject(ctx, types.ID(req.ProjectId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreProjectResponse{}, nil
+}
+
+// GetProjectContents returns the contents of the given project read from its
+// document.
+func (s *Server) GetProjectContents(
+	ctx context.Context,
+	req *pb.GetProjectContentsRequest,
+) (*pb.GetProjectContentsResponse, error) {
+	project, err := projects.Read(ctx, s.db, s.yorkieConf, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := json.Marshal(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetProjectContentsResponse{
+		Contents: string(contents),
+	}, nil
+}
+
+// DuplicateProject creates a new project copied from the given project.
+func (s *Server) DuplicateProject(
+	ctx context.Context,
+	req *pb.DuplicateProjectRequest,
+) (*pb.DuplicateProjectResponse, error) {
+	projectInfo, err := projects.Duplicate(
+		ctx,
+		s.db,
+		s.yorkieConf,
+		types.ID(req.ProjectId),
+		req.ProjectName,
+		types.ID(req.OrganizationId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DuplicateProjectResponse{
+		Project: converter.ToProject(projectInfo, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
+	}, nil
+}
+
+// TransferProject changes the owner of the given project. Only the owner can
+// transfer the project, and the admins of its organization cannot.
+func (s *Server) TransferProject(
+	ctx context.Context,
+	req *pb.TransferProjectRequest,
+) (*pb.TransferProjectResponse, error) {
+	if err := s.db.TransferProject(ctx, types.ID(req.ProjectId), req.NewOwner); err != nil {
+		return nil, err
+	}
+
+	return &pb.TransferProjectResponse{}, nil
+}
+
+// AddCollaborator adds the given user to the collaborators of the project.
+func (s *Server) AddCollaborator(
+	ctx context.Context,
+	req *pb.AddCollaboratorRequest,
+) (*pb.AddCollaboratorResponse, error) {
+	if err := s.db.AddCollaborator(
+		ctx,
+		types.ID(req.ProjectId),
+		req.UserId,
+		types.Role(req.Role),
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCollaboratorResponse{}, nil
+}
+
+// RemoveCollaborator removes the given user from the collaborators of the
+// project.
+func (s *Server) RemoveCollaborator(
+	ctx context.Context,
+	req *pb.RemoveCollaboratorRequest,
+) (*pb.RemoveCollaboratorResponse, error) {
+	if err := s.db.RemoveCollaborator(ctx, types.ID(req.ProjectId), req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveCollaboratorResponse{}, nil
+}
+
+// ListCollaborators returns the owner and the collaborators of the project.
+func (s *Server) ListCollaborators(
+	ctx context.Context,
+	req *pb.ListCollaboratorsRequest,
+) (*pb.ListCollaboratorsResponse, error) {
+	project, err := s.db.FindProject(ctx, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCollaboratorsResponse{
+		Collaborators: converter.ToCollaborators(project),
+	}, nil
+}
+
+// CreateOrganization creates a new organization of the given name.
+func (s *Server) CreateOrganization(
+	ctx context.Context,
+	req *pb.CreateOrganizationRequest,
+) (*pb.CreateOrganizationResponse, error) {
+	organization, err := s.db.CreateOrganization(ctx, req.OrganizationName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateOrganizationResponse{
+		Organization: converter.ToOrganization(organization),
+	}, nil
+}
+
+// ListOrganizations returns the organizations that the user is a member of.
+func (s *Server) ListOrganizations(
+	ctx context.Context,
+	req *pb.ListOrganizationsRequest,
+) (*pb.ListOrganizationsResponse, error) {
+	organizations, err := s.db.ListOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListOrganizationsResponse{
+		Organizations: converter.ToOrganizations(organizations),
+	}, nil
+}
+
+// AddOrganizationMember adds the given user to the members of the
+// organization.
+func (s *Server) AddOrganizationMember(
+	ctx context.Context,
+	req *pb.AddOrganizationMemberRequest,
+) (*pb.AddOrganizationMemberResponse, error) {
+	if err := s.db.AddMember(
+		ctx,
+		types.ID(req.OrganizationId),
+		req.UserId,
+		types.OrganizationRole(req.Role),
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddOrganizationMemberResponse{}, nil
+}
+
+// RemoveOrganizationMember removes the given user from the members of the
+// organization.
+func (s *Server) RemoveOrganizationMember(
+	ctx context.Context,
+	req *pb.RemoveOrganizationMemberRequest,
+) (*pb.RemoveOrganizationMemberResponse, error) {
+	if err := s.db.RemoveMember(ctx, types.ID(req.OrganizationId), req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveOrganizationMemberResponse{}, nil
+}
+
+// CreateInvite creates a new invite to the given project.
+func (s *Server) CreateInvite(
+	ctx context.Context,
+	req *pb.CreateInviteRequest,
+) (*pb.CreateInviteResponse, error) {
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	invite, err := invites.Create(
+		ctx,
+		s.db,
+		types.ID(req.ProjectId),
+		types.Role(req.Role),
+		int(req.MaxUses),
+		expiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateInviteResponse{
+		Invite: converter.ToInvite(invite),
+	}, nil
+}
+
+// AcceptInvite adds the user to the collaborators of the project of the given
+// invite token.
+func (s *Server) AcceptInvite(
+	ctx context.Context,
+	req *pb.AcceptInviteRequest,
+) (*pb.AcceptInviteResponse, error) {
+	project, err := s.db.AcceptInvite(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptInviteResponse{
+		Project: converter.ToProject(project, s.yorkieConf.Collection, types.UserIDFromCtx(ctx)),
+	}, nil
+}
+
+// RevokeInvite revokes the given invite.
+func (s *Server) RevokeInvite(
+	ctx context.Context,
+	req *pb.RevokeInviteRequest,
+) (*pb.RevokeInviteResponse, error) {
+	if err := invites.Revoke(ctx, s.db, types.ID(req.InviteId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeInviteResponse{}, nil
+}
+
+// ListInvites returns the invites to the given project.
+func (s *Server) ListInvites(
+	ctx context.Context,
+	req *pb.ListInvitesRequest,
+) (*pb.ListInvitesResponse, error) {
+	inviteInfos, err := invites.List(ctx, s.db, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListInvitesResponse{
+		Invites: converter.ToInvites(inviteInfos),
+	}, nil
+}
+
+// CreateAPIKey creates a new API key of the user.
+func (s *Server) CreateAPIKey(
+	ctx context.Context,
+	req *pb.CreateAPIKeyRequest,
+) (*pb.CreateAPIKeyResponse, error) {
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	apiKey, key, err := apikeys.Create(ctx, s.db, req.Name, types.APIKeyScope(req.Scope), expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAPIKeyResponse{
+		ApiKey: converter.ToAPIKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+// ListAPIKeys returns the API keys of the user.
+func (s *Server) ListAPIKeys(
+	ctx context.Context,
+	req *pb.ListAPIKeysRequest,
+) (*pb.ListAPIKeysResponse, error) {
+	apiKeys, err := s.db.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAPIKeysResponse{
+		ApiKeys: converter.ToAPIKeys(apiKeys),
+	}, nil
+}
+
+// RevokeAPIKey revokes the given API key of the user.
+func (s *Server) RevokeAPIKey(
+	ctx context.Context,
+	req *pb.RevokeAPIKeyRequest,
+) (*pb.RevokeAPIKeyResponse, error) {
+	if err := s.db.DeleteAPIKey(ctx, types.ID(req.ApiKeyId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
+
+// CreateTemplate creates a new template of the given name and contents.
+func (s *Server) CreateTemplate(
+	ctx context.Context,
+	req *pb.CreateTemplateRequest,
+) (*pb.CreateTemplateResponse, error) {
+	template, err := templates.Create(ctx, s.db, req.TemplateName, req.Contents, req.Public)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTemplateResponse{
+		Template: converter.ToTemplate(template),
+	}, nil
+}
+
+// ListTemplates returns the list of templates.
+func (s *Server) ListTemplates(
+	ctx context.Context,
+	req *pb.ListTemplatesRequest,
+) (*pb.ListTemplatesResponse, error) {
+	templateList, err := s.db.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListTemplatesResponse{
+		Templates: converter.ToTemplates(templateList),
+	}, nil
+}
+
+// GetTemplate returns the given template.
+func (s *Server) GetTemplate(
+	ctx context.Context,
+	req *pb.GetTemplateRequest,
+) (*pb.GetTemplateResponse, error) {
+	template, err := s.db.FindTemplate(ctx, types.ID(req.TemplateId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTemplateResponse{
+		Template: converter.ToTemplate(template),
+	}, nil
+}
+
+// UpdateTemplate updates the given template.
+func (s *Server) UpdateTemplate(
+	ctx context.Context,
+	req *pb.UpdateTemplateRequest,
+) (*pb.UpdateTemplateResponse, error) {
+	if err := templates.Update(
+		ctx,
+		s.db,
+		types.ID(req.TemplateId),
+		req.TemplateName,
+		req.Contents,
+		req.Public,
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTemplateResponse{}, nil
+}
+
+// DeleteTemplate deletes the given template.
+func (s *Server) DeleteTemplate(
+	ctx context.Context,
+	req *pb.DeleteTemplateRequest,
+) (*pb.DeleteTemplateResponse, error) {
+	if err := s.db.DeleteTemplate(ctx, types.ID(req.TemplateId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTemplateResponse{}, nil
+}
+
+// ListBuiltinTemplates returns the list of built-in templates.
+func (s *Server) ListBuiltinTemplates(
+	ctx context.Context,
+	req *pb.ListBuiltinTemplatesRequest,
+) (*pb.ListBuiltinTemplatesResponse, error) {
+	templateList, err := templates.ListBuiltins()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListBuiltinTemplatesResponse{
+		Templates: converter.ToTemplates(templateList),
+	}, nil
+}
+
+// SaveProjectAsTemplate creates a new template from the contents of the given
+// project.
+func (s *Server) SaveProjectAsTemplate(
+	ctx context.Context,
+	req *pb.SaveProjectAsTemplateRequest,
+) (*pb.SaveProjectAsTemplateResponse, error) {
+	template, err := projects.SaveAsTemplate(
+		ctx,
+		s.db,
+		s.yorkieConf,
+		types.ID(req.ProjectId),
+		req.TemplateName,
+		req.Public,
+		req.StripPositions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SaveProjectAsTemplateResponse{
+		Template: converter.ToTemplate(template),
+	}, nil
+}
+
+// GenerateCode generates PyTorch source code of the given project.
+func (s *Server) GenerateCode(
+	ctx context.Context,
+	req *pb.GenerateCodeRequest,
+) (*pb.GenerateCodeResponse, error) {
+	project, err := projects.Read(ctx, s.db, s.yorkieConf, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := codegen.Generate(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GenerateCodeResponse{
+		Code: code,
+	}, nil
+}
+
+// ValidateProject checks the contents of the given project and returns the
+// diagnostics found.
+func (s *Server) ValidateProject(
+	ctx context.Context,
+	req *pb.ValidateProjectRequest,
+) (*pb.ValidateProjectResponse, error) {
+	project, err := projects.Read(ctx, s.db, s.yorkieConf, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := validation.Validate(project)
+	return &pb.ValidateProjectResponse{
+		Valid:       !validation.HasError(diagnostics),
+		Diagnostics: converter.ToDiagnostics(diagnostics),
+	}, nil
+}
+
+// InferShapes infers the output shape of every block in the given project.
+func (s *Server) InferShapes(
+	ctx context.Context,
+	req *pb.InferShapesRequest,
+) (*pb.InferShapesResponse, error) {
+	project, err := projects.Read(ctx, s.db, s.yorkieConf, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	blockShapes, err := shapes.Infer(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InferShapesResponse{
+		Shapes: converter.ToBlockShapes(blockShapes),
+	}, nil
+}
+
+// AnalyzeProject counts the parameters and estimates the multiply-accumulate
+// operations of the given project.
+func (s *Server) AnalyzeProject(
+	ctx context.Context,
+	req *pb.AnalyzeProjectRequest,
+) (*pb.AnalyzeProjectResponse, error) {
+	project, err := projects.Read(ctx, s.db, s.yorkieConf, types.ID(req.ProjectId))
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := analysis.Analyze(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AnalyzeProjectResponse{
+		Params:   report.Params,
+		Macs:     report.MACs,
+		Networks: converter.ToNetworkStats(report.Networks),
+		Blocks:   converter.ToBlockStats(report.Blocks),
+	}, nil
+}
+
+// ListBlockTypes returns the specs of all block types.
+func (s *Server) ListBlockTypes(
+	ctx context.Context,
+	req *pb.ListBlockTypesRequest,
+) (*pb.ListBlockTypesResponse, error) {
+	specs, err := converter.ToBlockTypeSpecs(types.BlockSpecs())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListBlockTypesResponse{
+		BlockTypes: specs,
+	}, nil
+}
@@ -22,7 +22,9 @@ import (
 
 	"github.com/metis-labs/metis-server/server/database"
 	"github.com/metis-labs/metis-server/server/database/mongodb"
+	"github.com/metis-labs/metis-server/server/purge"
 	"github.com/metis-labs/metis-server/server/rpc"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
 	"github.com/metis-labs/metis-server/server/web"
 )
 
@@ -33,29 +35,37 @@ type Server struct {
 	webServer *web.Server
 	db        database.Database
 
+	purgeWorker *purge.Worker
+
 	shutdown   bool
 	shutdownCh chan struct{}
 }
 
 // New creates a new instance of Server.
 func New(conf *Config) (*Server, error) {
+	authenticator, err := auth.New(conf.Auth)
+	if err != nil {
+		return nil, err
+	}
+
 	dbClient := mongodb.NewClient(conf.Mongo)
-	rpcServer, err := rpc.NewServer(conf.RPC, conf.Yorkie, dbClient)
+	rpcServer, err := rpc.NewServer(conf.RPC, conf.Yorkie, dbClient, authenticator)
 	if err != nil {
 		return nil, err
 	}
 
-	webServer, err := web.NewServer(conf.Web, dbClient, conf.Yorkie)
+	webServer, err := web.NewServer(conf.Web, dbClient, conf.Yorkie, authenticator)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Server{
-		conf:       conf,
-		rpcServer:  rpcServer,
-		webServer:  webServer,
-		db:         dbClient,
-		shutdownCh: make(chan struct{}),
+		conf:        conf,
+		rpcServer:   rpcServer,
+		webServer:   webServer,
+		db:          dbClient,
+		purgeWorker: purge.NewWorker(conf.Purge, dbClient, conf.Yorkie),
+		shutdownCh:  make(chan struct{}),
 	}, nil
 }
 
@@ -69,7 +79,13 @@ func (s *Server) Start() error {
 		return err
 	}
 
-	return s.rpcServer.Start()
+	if err := s.rpcServer.Start(); err != nil {
+		return err
+	}
+
+	s.purgeWorker.Start()
+
+	return nil
 }
 
 // Shutdown shuts down this server.
@@ -90,6 +106,8 @@ func (s *Server) Shutdown(graceful bool) error {
 		s.webServer.Stop()
 	}
 
+	s.purgeWorker.Stop()
+
 	if err := s.db.Close(context.Background()); err != nil {
 		log.Print(err)
 	}
@@ -109,3 +127,8 @@ func (s *Server) ShutdownCh() <-chan struct{} {
 func (s *Server) RPCAddr() string {
 	return s.conf.RPCAddr()
 }
+
+// WebAddr returns the address of the web server.
+func (s *Server) WebAddr() string {
+	return s.conf.WebAddr()
+}
@@ -0,0 +1,405 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package shapes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+var (
+	// ErrShapeMismatch is returned when the shape of the input does not match
+	// what the block expects.
+	ErrShapeMismatch = errors.New("shape mismatch")
+
+	// ErrInvalidShape is returned when the input shape declared on the in
+	// block cannot be parsed.
+	ErrInvalidShape = errors.New("invalid shape")
+)
+
+// Dynamic is the size of the dimension that is not known until runtime, such
+// as the batch size declared with a name.
+const Dynamic = -1
+
+// Shape is the shape of a tensor.
+type Shape []int
+
+// String returns the string representation of this shape.
+func (s Shape) String() string {
+	var dims []string
+	for _, d := range s {
+		if d == Dynamic {
+			dims = append(dims, "?")
+		} else {
+			dims = append(dims, strconv.Itoa(d))
+		}
+	}
+	return "(" + strings.Join(dims, ", ") + ")"
+}
+
+func (s Shape) equals(other Shape) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if s[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// BlockShape is the output shape of a block, or the error that occurred while
+// inferring it.
+type BlockShape struct {
+	NetworkID string
+	BlockID   string
+	Shape     Shape
+	Err       error
+}
+
+var (
+	shapePattern = regexp.MustCompile(`^\s*(?:[A-Za-z_]\w*\s*[:=]\s*)?[\[(]?([^\[\]()]*)[\])]?\s*$`)
+	namePattern  = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+)
+
+// ParseShape parses the input shape declared on the in block. The shape is a
+// list of dimensions such as "[1, 3, 32, 32]" or "x: (N, 3, 32, 32)". Named
+// dimensions are Dynamic.
+func ParseShape(s string) (Shape, error) {
+	match := shapePattern.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("%q: %w", s, ErrInvalidShape)
+	}
+
+	var shape Shape
+	for _, token := range strings.Split(match[1], ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		if d, err := strconv.Atoi(token); err == nil && d > 0 {
+			shape = append(shape, d)
+		} else if namePattern.MatchString(token) {
+			shape = append(shape, Dynamic)
+		} else {
+			return nil, fmt.Errorf("%q: %w", s, ErrInvalidShape)
+		}
+	}
+	if len(shape) == 0 {
+		return nil, fmt.Errorf("%q: %w", s, ErrInvalidShape)
+	}
+
+	return shape, nil
+}
+
+// Infer infers the output shape of every block in the given project. It starts
+// from the input shapes declared on the in blocks of the networks that are not
+// referenced by other networks, and propagates them through the links.
+// Networks referenced by network blocks are inferred with the input shape of
+// the network block.
+func Infer(project *types.Project) ([]*BlockShape, error) {
+	networks, err := project.SortedNetworks()
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, n := range networks {
+		for _, b := range n.Blocks {
+			if b.Type == types.NetworkType {
+				referenced[b.RefNetwork] = true
+			}
+		}
+	}
+
+	inf := &inferrer{
+		project:  project,
+		recorded: make(map[string]bool),
+	}
+	for _, n := range networks {
+		if referenced[n.ID] {
+			continue
+		}
+		if _, err := inf.network(n, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return inf.shapes, nil
+}
+
+// inferrer holds the state used while inferring shapes of a project.
+type inferrer struct {
+	project  *types.Project
+	shapes   []*BlockShape
+	recorded map[string]bool
+}
+
+// record records the result of the given block. If a network is referenced
+// several times, the result of its first use is kept.
+func (inf *inferrer) record(n *types.Network, b *types.Block, shape Shape, err error) {
+	key := n.ID + "/" + b.ID
+	if inf.recorded[key] {
+		return
+	}
+	inf.recorded[key] = true
+
+	inf.shapes = append(inf.shapes, &BlockShape{
+		NetworkID: n.ID,
+		BlockID:   b.ID,
+		Shape:     shape,
+		Err:       err,
+	})
+}
+
+// network infers the shapes of the blocks of the given network and returns the
+// shape of its out block. If input is nil, the shape declared on the in block
+// is used.
+func (inf *inferrer) network(n *types.Network, input Shape) (Shape, error) {
+	blocks, err := n.SortedBlocks()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make(map[string]Shape)
+	var output Shape
+	for _, b := range blocks {
+		if b.Type == types.InType {
+			shape := input
+			if shape == nil {
+				if shape, err = ParseShape(b.InitVariables); err != nil {
+					inf.record(n, b, nil, err)
+					continue
+				}
+			}
+			outputs[b.ID] = shape
+			inf.record(n, b, shape, nil)
+			continue
+		}
+
+		in, err := inputShape(n, b, outputs)
+		if err != nil {
+			inf.record(n, b, nil, err)
+			continue
+		}
+		if in == nil {
+			continue
+		}
+
+		if b.Type == types.OutType {
+			if output == nil {
+				output = in
+			}
+			inf.record(n, b, in, nil)
+			continue
+		}
+
+		shape, err := inf.block(b, in)
+		if err != nil {
+			inf.record(n, b, nil, err)
+			continue
+		}
+		outputs[b.ID] = shape
+		inf.record(n, b, shape, nil)
+	}
+
+	return output, nil
+}
+
+// block returns the output shape of the given block applied Repeats times.
+func (inf *inferrer) block(b *types.Block, in Shape) (Shape, error) {
+	repeats := b.Repeats
+	if repeats < 1 {
+		repeats = 1
+	}
+
+	shape := in
+	for i := 0; i < repeats; i++ {
+		var err error
+		if b.Type == types.NetworkType {
+			shape, err = inf.networkBlock(b, shape)
+		} else {
+			shape, err = Layer(b, shape)
+		}
+		if err != nil {
+			if repeats > 1 {
+				return nil, fmt.Errorf("repeat %d: %w", i+1, err)
+			}
+			return nil, err
+		}
+	}
+
+	return shape, nil
+}
+
+func (inf *inferrer) networkBlock(b *types.Block, in Shape) (Shape, error) {
+	ref, ok := inf.project.Networks[b.RefNetwork]
+	if !ok {
+		return nil, fmt.Errorf("missing network %q: %w", b.RefNetwork, ErrShapeMismatch)
+	}
+
+	out, err := inf.network(ref, in)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, fmt.Errorf("network %q has no output: %w", ref.Name, ErrShapeMismatch)
+	}
+
+	return out, nil
+}
+
+// inputShape returns the shape of the input of the given block. Inputs from
+// several blocks are added, so their shapes must be the same. If any input is
+// not known, nil is returned.
+func inputShape(n *types.Network, b *types.Block, outputs map[string]Shape) (Shape, error) {
+	var shape Shape
+	for _, in := range n.Inputs(b.ID) {
+		out, ok := outputs[in.ID]
+		if !ok {
+			return nil, nil
+		}
+		if shape != nil && !shape.equals(out) {
+			return nil, fmt.Errorf(
+				"inputs %s and %s cannot be added: %w", shape, out, ErrShapeMismatch,
+			)
+		}
+		shape = out
+	}
+
+	return shape, nil
+}
+
+// Layer returns the output shape of a single application of the given normal
+// block to the given input shape. The input is (C, H, W) or (N, C, H, W).
+func Layer(b *types.Block, in Shape) (Shape, error) {
+	spec, ok := types.FindBlockSpec(b.Type)
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q: %w", b.Type, ErrShapeMismatch)
+	}
+
+	switch spec.Type {
+	case types.ReLUType:
+		return in, nil
+	case types.BatchNorm2dType:
+		if err := checkChannels(b, in, "num_features"); err != nil {
+			return nil, err
+		}
+		return in, nil
+	case types.Conv2dType:
+		if err := checkChannels(b, in, "in_channels"); err != nil {
+			return nil, err
+		}
+		outChannels, err := b.IntParameter("out_channels")
+		if err != nil {
+			return nil, err
+		}
+		return pool(b, in, outChannels, false)
+	case types.MaxPool2dType:
+		if err := checkImage(in); err != nil {
+			return nil, err
+		}
+		ceilMode, err := b.BoolParameter("ceil_mode")
+		if err != nil {
+			return nil, err
+		}
+		return pool(b, in, in[len(in)-3], ceilMode)
+	}
+
+	return nil, fmt.Errorf("type %q is not supported: %w", b.Type, ErrShapeMismatch)
+}
+
+// pool returns the output shape of the sliding window of the given block with
+// the given number of output channels.
+func pool(b *types.Block, in Shape, channels int, ceilMode bool) (Shape, error) {
+	kernelSize, err := b.IntParameter("kernel_size")
+	if err != nil {
+		return nil, err
+	}
+	stride, err := b.IntParameter("stride")
+	if errors.Is(err, types.ErrMissingParameter) && b.Type.Canonical() == types.MaxPool2dType {
+		// The stride of MaxPool2d has no default in the spec, because it
+		// defaults to the kernel size.
+		stride = kernelSize
+	} else if err != nil {
+		return nil, err
+	}
+	padding, err := b.IntParameter("padding")
+	if err != nil {
+		return nil, err
+	}
+	dilation, err := b.IntParameter("dilation")
+	if err != nil {
+		return nil, err
+	}
+
+	out := append(Shape{}, in...)
+	out[len(out)-3] = channels
+	for _, i := range []int{len(out) - 2, len(out) - 1} {
+		if in[i] == Dynamic {
+			continue
+		}
+
+		size := float64(in[i]+2*padding-dilation*(kernelSize-1)-1)/float64(stride) + 1
+		if ceilMode {
+			out[i] = int(math.Ceil(size))
+		} else {
+			out[i] = int(math.Floor(size))
+		}
+		if out[i] <= 0 {
+			return nil, fmt.Errorf(
+				"input %s is too small for kernel size %d: %w", in, kernelSize, ErrShapeMismatch,
+			)
+		}
+	}
+
+	return out, nil
+}
+
+// checkChannels checks that the input is an image with the number of channels
+// given by the parameter of the given name.
+func checkChannels(b *types.Block, in Shape, name string) error {
+	if err := checkImage(in); err != nil {
+		return err
+	}
+
+	channels, err := b.IntParameter(name)
+	if err != nil {
+		return err
+	}
+	if c := in[len(in)-3]; c != Dynamic && c != channels {
+		return fmt.Errorf("input %s has %d channels but %s is %d: %w", in, c, name, channels, ErrShapeMismatch)
+	}
+
+	return nil
+}
+
+// checkImage checks that the input is (C, H, W) or (N, C, H, W).
+func checkImage(in Shape) error {
+	if len(in) != 3 && len(in) != 4 {
+		return fmt.Errorf("input %s is not (N, C, H, W): %w", in, ErrShapeMismatch)
+	}
+	return nil
+}
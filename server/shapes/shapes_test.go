@@ -0,0 +1,92 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package shapes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/internal/testutil"
+	"github.com/metis-labs/metis-server/server/shapes"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestShapes(t *testing.T) {
+	t.Run("shape mismatch test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+		f.In.InitVariables = "[N, 3, 32, 32]"
+		conv := types.NewBlock(types.Conv2dType, "conv")
+		conv.Parameters = types.Parameters{
+			"in_channels":  1,
+			"out_channels": 6,
+			"kernel_size":  5,
+		}
+		f.Chain(f.In, conv, f.Out)
+
+		blockShapes, err := shapes.Infer(f.Project)
+		assert.NoError(t, err)
+
+		var mismatched bool
+		for _, blockShape := range blockShapes {
+			if errors.Is(blockShape.Err, shapes.ErrShapeMismatch) {
+				mismatched = true
+			}
+		}
+		assert.True(t, mismatched)
+	})
+
+	t.Run("stride test", func(t *testing.T) {
+		for _, tc := range []struct {
+			blockType types.BlockType
+			params    types.Parameters
+			shape     shapes.Shape
+			err       error
+		}{
+			// stride of MaxPool2d defaults to kernel_size, but that of Conv2d
+			// defaults to 1.
+			{types.MaxPool2dType, types.Parameters{"kernel_size": 2}, shapes.Shape{shapes.Dynamic, 3, 16, 16}, nil},
+			{types.MaxPool2dType, types.Parameters{"kernel_size": 2, "stride": 1}, shapes.Shape{shapes.Dynamic, 3, 31, 31}, nil},
+			{types.Conv2dType, types.Parameters{
+				"in_channels": 3, "out_channels": 6, "kernel_size": 2,
+			}, shapes.Shape{shapes.Dynamic, 6, 31, 31}, nil},
+			{types.MaxPool2dType, types.Parameters{"kernel_size": 2, "stride": 0}, nil, types.ErrInvalidParameter},
+			{types.MaxPool2dType, types.Parameters{"kernel_size": 2, "stride": "2"}, nil, types.ErrInvalidParameter},
+		} {
+			f := testutil.NewFixture(t.Name())
+			f.In.InitVariables = "[N, 3, 32, 32]"
+			block := types.NewBlock(tc.blockType, "block")
+			block.Parameters = tc.params
+			f.Chain(f.In, block, f.Out)
+
+			blockShapes, err := shapes.Infer(f.Project)
+			assert.NoError(t, err)
+			for _, blockShape := range blockShapes {
+				if blockShape.BlockID != block.ID {
+					continue
+				}
+				if tc.err != nil {
+					assert.True(t, errors.Is(blockShape.Err, tc.err), "%v", tc.params)
+				} else {
+					assert.NoError(t, blockShape.Err)
+					assert.Equal(t, tc.shape, blockShape.Shape)
+				}
+			}
+		}
+	})
+}
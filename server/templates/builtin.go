@@ -0,0 +1,255 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+// builtin is a template shipped with the server.
+type builtin struct {
+	name        string
+	displayName string
+	newProject  func() *types.Project
+}
+
+// builtins is the catalog of built-in templates.
+var builtins = []*builtin{
+	{name: "lenet", displayName: "LeNet-5", newProject: newLeNet},
+	{name: "vgg11", displayName: "VGG-11", newProject: newVGG11},
+	{name: "resnet18-basic-block", displayName: "ResNet-18 Basic Block", newProject: newResNetBasicBlock},
+	{name: "mlp", displayName: "MLP", newProject: newMLP},
+}
+
+// ListBuiltins returns the list of built-in templates. The name of the
+// built-in template is used as the ID of the template.
+func ListBuiltins() ([]*types.TemplateInfo, error) {
+	var infos []*types.TemplateInfo
+	for _, b := range builtins {
+		contents, err := json.Marshal(b.newProject())
+		if err != nil {
+			return nil, err
+		}
+
+		infos = append(infos, &types.TemplateInfo{
+			ID:       types.ID(b.name),
+			Name:     b.displayName,
+			Contents: string(contents),
+			Public:   true,
+		})
+	}
+
+	return infos, nil
+}
+
+// FindBuiltin returns the project of the built-in template of the given name.
+func FindBuiltin(name string) (*types.Project, error) {
+	for _, b := range builtins {
+		if b.name == name {
+			return b.newProject(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %w", name, database.ErrNotFound)
+}
+
+// newLeNet creates LeNet-5. The fully connected layers are expressed as
+// convolutions over 1x1 feature maps.
+func newLeNet() *types.Project {
+	return newProject("LeNet-5", newSequentialNetwork("Main", "[N, 1, 32, 32]",
+		newConv2d("conv1", 1, 6, 5, 1, 0),
+		newReLU("relu1"),
+		newMaxPool2d("pool1", 2, 2, 0),
+		newConv2d("conv2", 6, 16, 5, 1, 0),
+		newReLU("relu2"),
+		newMaxPool2d("pool2", 2, 2, 0),
+		newConv2d("conv3", 16, 120, 5, 1, 0),
+		newReLU("relu3"),
+		newConv2d("fc1", 120, 84, 1, 1, 0),
+		newReLU("relu4"),
+		newConv2d("fc2", 84, 10, 1, 1, 0),
+	))
+}
+
+// newVGG11 creates VGG-11(configuration A) with batch normalization. The
+// fully connected layers are expressed as convolutions.
+func newVGG11() *types.Project {
+	var blocks []*types.Block
+	inChannels := 3
+	for i, outChannels := range []int{64, 0, 128, 0, 256, 256, 0, 512, 512, 0, 512, 512, 0} {
+		if outChannels == 0 {
+			blocks = append(blocks, newMaxPool2d(fmt.Sprintf("pool%d", i+1), 2, 2, 0))
+			continue
+		}
+
+		blocks = append(blocks,
+			newConv2d(fmt.Sprintf("conv%d", i+1), inChannels, outChannels, 3, 1, 1),
+			newBatchNorm2d(fmt.Sprintf("bn%d", i+1), outChannels),
+			newReLU(fmt.Sprintf("relu%d", i+1)),
+		)
+		inChannels = outChannels
+	}
+
+	blocks = append(blocks,
+		newConv2d("fc1", 512, 4096, 7, 1, 0),
+		newReLU("fc1_relu"),
+		newConv2d("fc2", 4096, 4096, 1, 1, 0),
+		newReLU("fc2_relu"),
+		newConv2d("fc3", 4096, 1000, 1, 1, 0),
+	)
+
+	return newProject("VGG-11", newSequentialNetwork("Main", "[N, 3, 224, 224]", blocks...))
+}
+
+// newResNetBasicBlock creates the stem of ResNet-18 followed by two basic
+// blocks. The basic block is a separate network with a skip connection from
+// its input to the last activation.
+func newResNetBasicBlock() *types.Project {
+	basicBlock := newSequentialNetwork("BasicBlock", "",
+		newConv2d("conv1", 64, 64, 3, 1, 1),
+		newBatchNorm2d("bn1", 64),
+		newReLU("relu1"),
+		newConv2d("conv2", 64, 64, 3, 1, 1),
+		newBatchNorm2d("bn2", 64),
+		newReLU("relu2"),
+	)
+	var in, relu2 *types.Block
+	for _, b := range basicBlock.Blocks {
+		switch b.Name {
+		case "in":
+			in = b
+		case "relu2":
+			relu2 = b
+		}
+	}
+	shortcut := types.NewLink(in.ID, relu2.ID)
+	basicBlock.Links[shortcut.ID] = shortcut
+
+	layer := newNetworkBlock("layer1", basicBlock.ID, 2)
+	main := newSequentialNetwork("Main", "[N, 3, 224, 224]",
+		newConv2d("conv1", 3, 64, 7, 2, 3),
+		newBatchNorm2d("bn1", 64),
+		newReLU("relu1"),
+		newMaxPool2d("pool1", 3, 2, 1),
+		layer,
+	)
+
+	return newProject("ResNet-18 Basic Block", main, basicBlock)
+}
+
+// newMLP creates a multi layer perceptron for flattened 28x28 images. The
+// linear layers are expressed as 1x1 convolutions.
+func newMLP() *types.Project {
+	return newProject("MLP", newSequentialNetwork("Main", "[N, 784, 1, 1]",
+		newConv2d("fc1", 784, 256, 1, 1, 0),
+		newReLU("relu1"),
+		newConv2d("fc2", 256, 128, 1, 1, 0),
+		newReLU("relu2"),
+		newConv2d("fc3", 128, 10, 1, 1, 0),
+	))
+}
+
+func newProject(name string, networks ...*types.Network) *types.Project {
+	project := &types.Project{
+		Name:     name,
+		Networks: make(map[string]*types.Network),
+	}
+	for _, network := range networks {
+		project.Networks[network.ID] = network
+	}
+
+	return project
+}
+
+// newSequentialNetwork creates a network that links the given blocks one after
+// another between the in and out blocks of the default network. inputShape is
+// declared on the in block for shape inference.
+func newSequentialNetwork(name string, inputShape string, blocks ...*types.Block) *types.Network {
+	network := types.NewDefaultNetwork()
+	network.Name = name
+
+	var in, out *types.Block
+	for _, b := range network.Blocks {
+		switch b.Type {
+		case types.InType:
+			in = b
+		case types.OutType:
+			out = b
+		}
+	}
+
+	in.InitVariables = inputShape
+
+	prev := in
+	for i, b := range append(blocks, out) {
+		b.Position = &types.Position{X: in.Position.X, Y: in.Position.Y + (i+1)*100}
+		network.Blocks[b.ID] = b
+
+		link := types.NewLink(prev.ID, b.ID)
+		network.Links[link.ID] = link
+		prev = b
+	}
+
+	return network
+}
+
+func newNormalBlock(blockType types.BlockType, name string, params types.Parameters) *types.Block {
+	block := types.NewBlock(blockType, name)
+	block.Repeats = 1
+	block.Parameters = params
+	return block
+}
+
+func newNetworkBlock(name string, refNetwork string, repeats int) *types.Block {
+	block := types.NewBlock(types.NetworkType, name)
+	block.RefNetwork = refNetwork
+	block.Repeats = repeats
+	block.Parameters = types.Parameters{}
+	return block
+}
+
+func newConv2d(name string, inChannels, outChannels, kernelSize, stride, padding int) *types.Block {
+	return newNormalBlock(types.Conv2dType, name, types.Parameters{
+		"in_channels":  inChannels,
+		"out_channels": outChannels,
+		"kernel_size":  kernelSize,
+		"stride":       stride,
+		"padding":      padding,
+	})
+}
+
+func newBatchNorm2d(name string, numFeatures int) *types.Block {
+	return newNormalBlock(types.BatchNorm2dType, name, types.Parameters{
+		"num_features": numFeatures,
+	})
+}
+
+func newReLU(name string) *types.Block {
+	return newNormalBlock(types.ReLUType, name, types.Parameters{})
+}
+
+func newMaxPool2d(name string, kernelSize, stride, padding int) *types.Block {
+	return newNormalBlock(types.MaxPool2dType, name, types.Parameters{
+		"kernel_size": kernelSize,
+		"stride":      stride,
+		"padding":     padding,
+	})
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+// ErrInvalidTemplate is returned when the contents of the template cannot be
+// decoded into a project.
+var ErrInvalidTemplate = errors.New("invalid template")
+
+// Create creates a new template after checking that the given contents can be
+// decoded into a project.
+func Create(
+	ctx context.Context,
+	db database.Database,
+	name string,
+	contents string,
+	public bool,
+) (*types.TemplateInfo, error) {
+	if _, err := Decode(contents); err != nil {
+		return nil, err
+	}
+
+	return db.CreateTemplate(ctx, name, contents, public)
+}
+
+// Update updates the given template after checking that the given contents
+// can be decoded into a project.
+func Update(
+	ctx context.Context,
+	db database.Database,
+	id types.ID,
+	name string,
+	contents string,
+	public bool,
+) error {
+	if _, err := Decode(contents); err != nil {
+		return err
+	}
+
+	return db.UpdateTemplate(ctx, id, name, contents, public)
+}
+
+// Load returns the project to seed a new project from. It is loaded from the
+// stored template of the given ID or the built-in template of the given name.
+// If neither is given, nil is returned.
+func Load(
+	ctx context.Context,
+	db database.Database,
+	templateID string,
+	builtinName string,
+) (*types.Project, error) {
+	if templateID != "" && builtinName != "" {
+		return nil, fmt.Errorf("both template and built-in template given: %w", ErrInvalidTemplate)
+	}
+
+	if templateID != "" {
+		return Find(ctx, db, types.ID(templateID))
+	}
+	if builtinName != "" {
+		return FindBuiltin(builtinName)
+	}
+
+	return nil, nil
+}
+
+// Find returns the project decoded from the template of the given ID. All IDs
+// of the project are renewed so that they do not collide with the projects
+// created from the same template.
+func Find(ctx context.Context, db database.Database, id types.ID) (*types.Project, error) {
+	templateInfo, err := db.FindTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := Decode(templateInfo.Contents)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", id, err)
+	}
+	project.RenewIDs()
+
+	return project, nil
+}
+
+// Decode decodes the given contents of the template into a project.
+func Decode(contents string) (*types.Project, error) {
+	project := &types.Project{}
+	if err := json.Unmarshal([]byte(contents), project); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidTemplate)
+	}
+	if len(project.Networks) == 0 {
+		return nil, fmt.Errorf("no networks: %w", ErrInvalidTemplate)
+	}
+
+	return project, nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "time"
+
+// APIKeyScope is the scope of the methods that the API key can call.
+type APIKeyScope string
+
+// Belows are the scopes of API keys.
+const (
+	// APIKeyRead can only call the methods that read data.
+	APIKeyRead APIKeyScope = "read"
+
+	// APIKeyReadWrite can call the methods that read or write data.
+	APIKeyReadWrite APIKeyScope = "read_write"
+)
+
+// APIKeyInfo represents the personal API key used to call the API without
+// user tokens. Only the hash of the key is stored, and Prefix is kept for
+// users to tell keys apart.
+type APIKeyInfo struct {
+	ID         ID          `bson:"_id_fake"`
+	Name       string      `bson:"name"`
+	Owner      string      `bson:"owner"`
+	Prefix     string      `bson:"prefix"`
+	Hash       string      `bson:"hash"`
+	Scope      APIKeyScope `bson:"scope"`
+	LastUsedAt time.Time   `bson:"last_used_at"`
+	ExpiresAt  time.Time   `bson:"expires_at"`
+	CreatedAt  time.Time   `bson:"created_at"`
+}
+
+// Expired returns whether the key is expired at the given time. Keys without
+// expiry never expire.
+func (k *APIKeyInfo) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
@@ -21,17 +21,20 @@ import "github.com/rs/xid"
 // BlockType is a type of block.
 type BlockType string
 
-// Belows are the types of the block.
-// TODO(youngteac.hong): We need to find a way to simplify types for Normal Block.
-// It is burdensome to add a type every time we add a normal block.
+// Belows are the types of the block. The parameters of each type are described
+// by the registry in block_spec.go.
 const (
 	InType          BlockType = "In"
 	OutType         BlockType = "Out"
 	NetworkType     BlockType = "Network"
 	Conv2dType      BlockType = "Conv2d"
-	BatchNorm2dType BlockType = "BachNorm2d"
+	BatchNorm2dType BlockType = "BatchNorm2d"
 	ReLUType        BlockType = "ReLU"
 	MaxPool2dType   BlockType = "MaxPool2d"
+
+	// BachNorm2dType is the misspelled type of BatchNorm2d kept as an alias
+	// for the projects created before it was fixed.
+	BachNorm2dType BlockType = "BachNorm2d"
 )
 
 // Position represents point on the canvas.
@@ -0,0 +1,295 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrInvalidParameter is returned when the parameter value does not match
+	// the spec of the parameter.
+	ErrInvalidParameter = errors.New("invalid parameter")
+
+	// ErrMissingParameter is returned when the block does not have the
+	// parameter and the parameter has no default value.
+	ErrMissingParameter = errors.New("missing parameter")
+)
+
+// ParameterType is a type of parameter value.
+type ParameterType string
+
+// Belows are the types of parameter value.
+const (
+	IntParameter    ParameterType = "int"
+	FloatParameter  ParameterType = "float"
+	BoolParameter   ParameterType = "bool"
+	StringParameter ParameterType = "string"
+)
+
+// ParameterSpec describes a parameter of the block. Min and Max are nil if the
+// value is not bounded.
+type ParameterSpec struct {
+	Name     string
+	Type     ParameterType
+	Default  ParameterValue
+	Required bool
+	Min      *float64
+	Max      *float64
+}
+
+// BlockSpec describes a type of block.
+type BlockSpec struct {
+	Type BlockType
+
+	// Aliases are other names of the type kept for compatibility.
+	Aliases []BlockType
+
+	// TorchClass is the class of torch.nn for normal blocks.
+	TorchClass string
+
+	// Repeatable is whether the block takes Repeats.
+	Repeatable bool
+
+	Parameters []*ParameterSpec
+}
+
+// blockSpecs is the registry of block types.
+var blockSpecs = []*BlockSpec{
+	{Type: InType},
+	{Type: OutType},
+	{Type: NetworkType, Repeatable: true},
+	{
+		Type:       Conv2dType,
+		TorchClass: "Conv2d",
+		Repeatable: true,
+		Parameters: []*ParameterSpec{
+			{Name: "in_channels", Type: IntParameter, Required: true, Min: bound(1)},
+			{Name: "out_channels", Type: IntParameter, Required: true, Min: bound(1)},
+			{Name: "kernel_size", Type: IntParameter, Required: true, Min: bound(1)},
+			{Name: "stride", Type: IntParameter, Default: 1, Min: bound(1)},
+			{Name: "padding", Type: IntParameter, Default: 0, Min: bound(0)},
+			{Name: "dilation", Type: IntParameter, Default: 1, Min: bound(1)},
+			{Name: "groups", Type: IntParameter, Default: 1, Min: bound(1)},
+			{Name: "bias", Type: BoolParameter, Default: true},
+			{Name: "padding_mode", Type: StringParameter, Default: "zeros"},
+		},
+	},
+	{
+		Type:       BatchNorm2dType,
+		Aliases:    []BlockType{BachNorm2dType},
+		TorchClass: "BatchNorm2d",
+		Repeatable: true,
+		Parameters: []*ParameterSpec{
+			{Name: "num_features", Type: IntParameter, Required: true, Min: bound(1)},
+			{Name: "eps", Type: FloatParameter, Default: 1e-5, Min: bound(0)},
+			{Name: "momentum", Type: FloatParameter, Default: 0.1, Min: bound(0), Max: bound(1)},
+			{Name: "affine", Type: BoolParameter, Default: true},
+			{Name: "track_running_stats", Type: BoolParameter, Default: true},
+		},
+	},
+	{
+		Type:       ReLUType,
+		TorchClass: "ReLU",
+		Repeatable: true,
+		Parameters: []*ParameterSpec{
+			{Name: "inplace", Type: BoolParameter, Default: false},
+		},
+	},
+	{
+		Type:       MaxPool2dType,
+		TorchClass: "MaxPool2d",
+		Repeatable: true,
+		Parameters: []*ParameterSpec{
+			{Name: "kernel_size", Type: IntParameter, Required: true, Min: bound(1)},
+			// Stride defaults to kernel_size in PyTorch.
+			{Name: "stride", Type: IntParameter, Min: bound(1)},
+			{Name: "padding", Type: IntParameter, Default: 0, Min: bound(0)},
+			{Name: "dilation", Type: IntParameter, Default: 1, Min: bound(1)},
+			{Name: "return_indices", Type: BoolParameter, Default: false},
+			{Name: "ceil_mode", Type: BoolParameter, Default: false},
+		},
+	},
+}
+
+// BlockSpecs returns the specs of all block types.
+func BlockSpecs() []*BlockSpec {
+	return blockSpecs
+}
+
+// FindBlockSpec returns the spec of the given block type. Aliases of the type
+// are also resolved.
+func FindBlockSpec(blockType BlockType) (*BlockSpec, bool) {
+	for _, spec := range blockSpecs {
+		if spec.Type == blockType {
+			return spec, true
+		}
+		for _, alias := range spec.Aliases {
+			if alias == blockType {
+				return spec, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// Canonical returns the type that this type is an alias of, or this type
+// itself if it is not an alias.
+func (t BlockType) Canonical() BlockType {
+	if spec, ok := FindBlockSpec(t); ok {
+		return spec.Type
+	}
+	return t
+}
+
+// Parameter returns the spec of the parameter of the given name.
+func (s *BlockSpec) Parameter(name string) (*ParameterSpec, bool) {
+	for _, param := range s.Parameters {
+		if param.Name == name {
+			return param, true
+		}
+	}
+
+	return nil, false
+}
+
+// Coerce converts the given value to the type of this parameter and checks
+// that it is within the range of this parameter.
+func (s *ParameterSpec) Coerce(v ParameterValue) (ParameterValue, error) {
+	coerced, err := s.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var n float64
+	switch c := coerced.(type) {
+	case int:
+		n = float64(c)
+	case float64:
+		n = c
+	default:
+		return coerced, nil
+	}
+	if s.Min != nil && n < *s.Min {
+		return nil, fmt.Errorf("%s: %v is less than %v: %w", s.Name, v, *s.Min, ErrInvalidParameter)
+	}
+	if s.Max != nil && n > *s.Max {
+		return nil, fmt.Errorf("%s: %v is greater than %v: %w", s.Name, v, *s.Max, ErrInvalidParameter)
+	}
+
+	return coerced, nil
+}
+
+// Convert converts the given value to the type of this parameter. Numbers
+// decoded from JSON are float64, so integral float values are accepted for
+// int parameters.
+func (s *ParameterSpec) Convert(v ParameterValue) (ParameterValue, error) {
+	var converted ParameterValue
+	switch s.Type {
+	case IntParameter:
+		switch n := v.(type) {
+		case int:
+			converted = n
+		case int64:
+			converted = int(n)
+		case float64:
+			if n == math.Trunc(n) {
+				converted = int(n)
+			}
+		}
+	case FloatParameter:
+		switch n := v.(type) {
+		case int:
+			converted = float64(n)
+		case int64:
+			converted = float64(n)
+		case float64:
+			converted = n
+		}
+	case BoolParameter:
+		if b, ok := v.(bool); ok {
+			converted = b
+		}
+	case StringParameter:
+		if str, ok := v.(string); ok {
+			converted = str
+		}
+	}
+
+	if converted == nil {
+		return nil, fmt.Errorf("%s: %v is not %s: %w", s.Name, v, s.Type, ErrInvalidParameter)
+	}
+
+	return converted, nil
+}
+
+// Parameter returns the parameter of the given name converted to its type by
+// the spec of the block type, or its default value if the block does not have
+// it.
+func (b *Block) Parameter(name string) (ParameterValue, error) {
+	spec, ok := FindBlockSpec(b.Type)
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q: %w", b.Type, ErrInvalidParameter)
+	}
+	param, ok := spec.Parameter(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown parameter %q: %w", name, ErrInvalidParameter)
+	}
+
+	v, ok := b.Parameters[name]
+	if !ok {
+		v = param.Default
+	}
+	if v == nil {
+		return nil, fmt.Errorf("%q: %w", name, ErrMissingParameter)
+	}
+
+	return param.Coerce(v)
+}
+
+// IntParameter returns the int parameter of the given name.
+func (b *Block) IntParameter(name string) (int, error) {
+	v, err := b.Parameter(name)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("%s: %v is not %s: %w", name, v, IntParameter, ErrInvalidParameter)
+	}
+	return n, nil
+}
+
+// BoolParameter returns the bool parameter of the given name.
+func (b *Block) BoolParameter(name string) (bool, error) {
+	v, err := b.Parameter(name)
+	if err != nil {
+		return false, err
+	}
+	flag, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s: %v is not %s: %w", name, v, BoolParameter, ErrInvalidParameter)
+	}
+	return flag, nil
+}
+
+func bound(v float64) *float64 {
+	return &v
+}
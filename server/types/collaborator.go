@@ -0,0 +1,60 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "errors"
+
+// ErrInvalidRole is returned when the role cannot be given to the user.
+var ErrInvalidRole = errors.New("invalid role")
+
+// Role is the role of the user in the project.
+type Role string
+
+// Belows are the roles of the user in the project.
+const (
+	// RoleOwner can do everything in the project including managing its
+	// collaborators.
+	RoleOwner Role = "owner"
+
+	// RoleEditor can read and write the document of the project.
+	RoleEditor Role = "editor"
+
+	// RoleViewer can only read the document of the project.
+	RoleViewer Role = "viewer"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+// Collaborator is a user who shares the project with the owner.
+type Collaborator struct {
+	UserID string `bson:"user_id"`
+	Role   Role   `bson:"role"`
+}
+
+// CanWrite returns whether the role can write the document of the project.
+func (r Role) CanWrite() bool {
+	return r == RoleOwner || r == RoleEditor
+}
+
+// Covers returns whether the role has all permissions of the given role.
+func (r Role) Covers(other Role) bool {
+	return roleRanks[r] >= roleRanks[other]
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrCycleDetected is returned when the links of a network form a cycle.
+	ErrCycleDetected = errors.New("cycle detected")
+
+	// ErrRecursiveNetwork is returned when a network references itself
+	// directly or through other networks.
+	ErrRecursiveNetwork = errors.New("recursive network")
+)
+
+// Inputs returns the blocks linked to the block of the given ID. Links that
+// point to missing blocks are ignored.
+func (n *Network) Inputs(blockID string) []*Block {
+	var inputs []*Block
+	for _, l := range n.Links {
+		if l.To != blockID {
+			continue
+		}
+		if from, ok := n.Blocks[l.From]; ok {
+			inputs = append(inputs, from)
+		}
+	}
+	sortBlocks(inputs)
+
+	return inputs
+}
+
+// SortedBlocks returns the blocks of this network in topological order of
+// links. Blocks that do not depend on each other are ordered by name so that
+// the result is deterministic.
+func (n *Network) SortedBlocks() ([]*Block, error) {
+	inDegrees := make(map[string]int)
+	outputs := make(map[string][]*Block)
+	for _, l := range n.Links {
+		from, ok := n.Blocks[l.From]
+		if !ok {
+			continue
+		}
+		to, ok := n.Blocks[l.To]
+		if !ok {
+			continue
+		}
+		inDegrees[to.ID]++
+		outputs[from.ID] = append(outputs[from.ID], to)
+	}
+
+	var ready []*Block
+	for _, b := range n.Blocks {
+		if inDegrees[b.ID] == 0 {
+			ready = append(ready, b)
+		}
+	}
+
+	var sorted []*Block
+	for len(ready) > 0 {
+		sortBlocks(ready)
+		b := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, b)
+
+		for _, out := range outputs[b.ID] {
+			inDegrees[out.ID]--
+			if inDegrees[out.ID] == 0 {
+				ready = append(ready, out)
+			}
+		}
+	}
+
+	if len(sorted) != len(n.Blocks) {
+		return nil, fmt.Errorf("%s: %w", n.Name, ErrCycleDetected)
+	}
+
+	return sorted, nil
+}
+
+// SortedNetworks returns the networks of this project so that networks
+// referenced by network blocks come before the networks referencing them.
+// References to missing networks are ignored.
+func (p *Project) SortedNetworks() ([]*Network, error) {
+	var networks []*Network
+	for _, n := range p.Networks {
+		networks = append(networks, n)
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		if networks[i].Name != networks[j].Name {
+			return networks[i].Name < networks[j].Name
+		}
+		return networks[i].ID < networks[j].ID
+	})
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := make(map[string]int)
+	var sorted []*Network
+
+	var visit func(n *Network) error
+	visit = func(n *Network) error {
+		switch states[n.ID] {
+		case visiting:
+			return fmt.Errorf("%s: %w", n.Name, ErrRecursiveNetwork)
+		case visited:
+			return nil
+		}
+
+		states[n.ID] = visiting
+		for _, b := range n.SortedBlocksByName() {
+			if b.Type != NetworkType {
+				continue
+			}
+			if ref, ok := p.Networks[b.RefNetwork]; ok {
+				if err := visit(ref); err != nil {
+					return err
+				}
+			}
+		}
+		states[n.ID] = visited
+		sorted = append(sorted, n)
+
+		return nil
+	}
+
+	for _, n := range networks {
+		if err := visit(n); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
+
+// SortedBlocksByName returns the blocks of this network ordered by name.
+func (n *Network) SortedBlocksByName() []*Block {
+	var blocks []*Block
+	for _, b := range n.Blocks {
+		blocks = append(blocks, b)
+	}
+	sortBlocks(blocks)
+
+	return blocks
+}
+
+func sortBlocks(blocks []*Block) {
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].Name != blocks[j].Name {
+			return blocks[i].Name < blocks[j].Name
+		}
+		return blocks[i].ID < blocks[j].ID
+	})
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "time"
+
+// InviteInfo represents the invite to the project. A user who has the token of
+// the invite can join the project as a collaborator with the role of the
+// invite until it expires or is used MaxUses times. If MaxUses is 0, the
+// invite can be used any number of times.
+type InviteInfo struct {
+	ID        ID        `bson:"_id_fake"`
+	ProjectID ID        `bson:"project_id_fake"`
+	Token     string    `bson:"token"`
+	Role      Role      `bson:"role"`
+	MaxUses   int       `bson:"max_uses"`
+	Uses      int       `bson:"uses"`
+	CreatedBy string    `bson:"created_by"`
+	ExpiresAt time.Time `bson:"expires_at"`
+	CreatedAt time.Time `bson:"created_at"`
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "time"
+
+// OrganizationRole is the role of the member in the organization.
+type OrganizationRole string
+
+// Belows are the roles of the member in the organization.
+const (
+	// OrganizationAdmin manages the members of the organization and owns the
+	// projects of the organization.
+	OrganizationAdmin OrganizationRole = "admin"
+
+	// OrganizationMember edits the projects of the organization.
+	OrganizationMember OrganizationRole = "member"
+)
+
+// ProjectRole returns the role in the projects of the organization given to
+// the member of this role.
+func (r OrganizationRole) ProjectRole() Role {
+	if r == OrganizationAdmin {
+		return RoleOwner
+	}
+	return RoleEditor
+}
+
+// Member is a user who belongs to the organization.
+type Member struct {
+	UserID string           `bson:"user_id"`
+	Role   OrganizationRole `bson:"role"`
+}
+
+// OrganizationInfo represents the organization, a group of users that can own
+// projects together.
+type OrganizationInfo struct {
+	ID        ID        `bson:"_id_fake"`
+	Name      string    `bson:"name"`
+	Members   []*Member `bson:"members"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// RoleOf returns the role of the given user in the organization. It returns
+// false if the user is not a member.
+func (o *OrganizationInfo) RoleOf(userID string) (OrganizationRole, bool) {
+	for _, member := range o.Members {
+		if member.UserID == userID {
+			return member.Role, true
+		}
+	}
+
+	return "", false
+}
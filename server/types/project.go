@@ -25,6 +25,15 @@ type Link struct {
 	To   string `json:"to"`
 }
 
+// NewLink creates a new instance of Link between the given blocks.
+func NewLink(from, to string) *Link {
+	return &Link{
+		ID:   xid.New().String(),
+		From: from,
+		To:   to,
+	}
+}
+
 // Dependency represents package dependency used in import statements.
 type Dependency struct {
 	ID      string `json:"id"`
@@ -120,3 +129,81 @@ func NewProject(id string, name string) *Project {
 		Networks: networks,
 	}
 }
+
+// StripPositions removes the positions of all blocks of this project.
+func (p *Project) StripPositions() {
+	for _, n := range p.Networks {
+		for _, b := range n.Blocks {
+			b.Position = nil
+		}
+	}
+}
+
+// RenewIDs assigns fresh IDs to all networks, blocks, links and dependencies
+// of this project. References between them, such as RefNetwork of blocks and
+// From and To of links, are remapped to the new IDs.
+func (p *Project) RenewIDs() {
+	networkIDs := make(map[string]string)
+	for id := range p.Networks {
+		networkIDs[id] = xid.New().String()
+	}
+
+	networks := make(map[string]*Network)
+	for id, n := range p.Networks {
+		n.ID = networkIDs[id]
+		n.renewIDs(networkIDs)
+		networks[n.ID] = n
+	}
+	p.Networks = networks
+}
+
+// renewIDs assigns fresh IDs to the blocks, links and dependencies of this
+// network.
+func (n *Network) renewIDs(networkIDs map[string]string) {
+	if n.Dependencies != nil {
+		n.Dependencies.BuiltInDeps = renewDependencyIDs(n.Dependencies.BuiltInDeps)
+		n.Dependencies.ThirdPartyDeps = renewDependencyIDs(n.Dependencies.ThirdPartyDeps)
+		n.Dependencies.ProjectDeps = renewDependencyIDs(n.Dependencies.ProjectDeps)
+	}
+
+	blockIDs := make(map[string]string)
+	blocks := make(map[string]*Block)
+	for id, b := range n.Blocks {
+		b.ID = xid.New().String()
+		blockIDs[id] = b.ID
+		if b.Type == NetworkType {
+			if refNetwork, ok := networkIDs[b.RefNetwork]; ok {
+				b.RefNetwork = refNetwork
+			}
+		}
+		blocks[b.ID] = b
+	}
+	n.Blocks = blocks
+
+	links := make(map[string]*Link)
+	for _, l := range n.Links {
+		l.ID = xid.New().String()
+		if from, ok := blockIDs[l.From]; ok {
+			l.From = from
+		}
+		if to, ok := blockIDs[l.To]; ok {
+			l.To = to
+		}
+		links[l.ID] = l
+	}
+	n.Links = links
+}
+
+// renewDependencyIDs assigns fresh IDs to the given dependencies.
+func renewDependencyIDs(deps map[string]*Dependency) map[string]*Dependency {
+	if deps == nil {
+		return nil
+	}
+
+	renewed := make(map[string]*Dependency)
+	for _, d := range deps {
+		d.ID = xid.New().String()
+		renewed[d.ID] = d
+	}
+	return renewed
+}
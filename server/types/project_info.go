@@ -16,14 +16,82 @@
 
 package types
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // ProjectInfo represents the metadata of the project of Metis.
 type ProjectInfo struct {
-	ID        ID        `bson:"_id_fake"`
-	Name      string    `bson:"name"`
-	Owner     string    `bson:"owner"`
-	Status    string    `bson:"status"`
-	CreatedAt time.Time `bson:"created_at"`
-	DeletedAt time.Time `bson:"deleted_at"`
+	ID             ID              `bson:"_id_fake"`
+	Name           string          `bson:"name"`
+	Description    string          `bson:"description"`
+	Tags           []string        `bson:"tags"`
+	StarredBy      []string        `bson:"starred_by"`
+	Owner          string          `bson:"owner"`
+	OrganizationID ID              `bson:"organization_id_fake"`
+	ForkedFrom     ID              `bson:"forked_from_fake"`
+	Collaborators  []*Collaborator `bson:"collaborators"`
+	Transfers      []*Transfer     `bson:"transfers"`
+	Status         string          `bson:"status"`
+	CreatedAt      time.Time       `bson:"created_at"`
+	DeletedAt      time.Time       `bson:"deleted_at"`
+
+	// UpdatedAt and LastEditor are of the most recent edit of the contents of
+	// the project. UpdatedAt is CreatedAt until the contents are edited.
+	UpdatedAt  time.Time `bson:"updated_at"`
+	LastEditor string    `bson:"last_editor"`
+}
+
+// Transfer is the record of the change of the owner of the project.
+type Transfer struct {
+	From          string    `bson:"from"`
+	To            string    `bson:"to"`
+	TransferredBy string    `bson:"transferred_by"`
+	TransferredAt time.Time `bson:"transferred_at"`
+}
+
+// IsStarredBy returns whether the given user starred the project.
+func (p *ProjectInfo) IsStarredBy(userID string) bool {
+	for _, starredBy := range p.StarredBy {
+		if starredBy == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RoleOf returns the role of the given user in the project. It returns false
+// if the user is neither the owner nor a collaborator. Roles given through the
+// organization of the project are not considered.
+func (p *ProjectInfo) RoleOf(userID string) (Role, bool) {
+	if p.Owner == userID {
+		return RoleOwner, true
+	}
+
+	for _, collaborator := range p.Collaborators {
+		if collaborator.UserID == userID {
+			return collaborator.Role, true
+		}
+	}
+
+	return "", false
+}
+
+// NormalizeTags trims the given tags and removes empty and duplicated ones,
+// keeping the order of the first occurrences.
+func NormalizeTags(tags []string) []string {
+	normalized := []string{}
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
 }
@@ -24,5 +24,6 @@ type TemplateInfo struct {
 	Name      string    `bson:"name"`
 	Owner     string    `bson:"owner"`
 	Contents  string    `bson:"contents"`
+	Public    bool      `bson:"public"`
 	CreatedAt time.Time `bson:"created_at"`
 }
@@ -0,0 +1,366 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+// Severity is the severity of the diagnostic.
+type Severity string
+
+// Belows are the severities of the diagnostic.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Belows are the codes of the diagnostic.
+const (
+	CodeMissingBlock      = "missing-block"
+	CodeCycle             = "cycle"
+	CodeMissingIn         = "missing-in"
+	CodeDuplicateIn       = "duplicate-in"
+	CodeMissingOut        = "missing-out"
+	CodeDuplicateOut      = "duplicate-out"
+	CodeMissingRefNetwork = "missing-ref-network"
+	CodeRecursiveNetwork  = "recursive-network"
+	CodeInvalidRepeats    = "invalid-repeats"
+	CodeUnknownBlockType  = "unknown-block-type"
+	CodeUnreachableBlock  = "unreachable-block"
+	CodeMissingParameter  = "missing-parameter"
+	CodeInvalidParameter  = "invalid-parameter"
+	CodeUnknownParameter  = "unknown-parameter"
+)
+
+// Diagnostic is a problem found in the project. NetworkID, BlockID and LinkID
+// point to the element that causes the problem if any.
+type Diagnostic struct {
+	Severity  Severity
+	Code      string
+	Message   string
+	NetworkID string
+	BlockID   string
+	LinkID    string
+}
+
+// Validate checks the given project and returns the diagnostics found. The
+// project is valid if there is no diagnostic of SeverityError.
+func Validate(project *types.Project) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	for _, n := range sortedNetworks(project) {
+		diagnostics = append(diagnostics, validateNetwork(project, n)...)
+	}
+
+	return append(diagnostics, validateReferences(project)...)
+}
+
+// HasError returns whether the given diagnostics contain an error.
+func HasError(diagnostics []*Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func validateNetwork(project *types.Project, n *types.Network) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	report := func(severity Severity, code string, b *types.Block, format string, args ...interface{}) {
+		d := &Diagnostic{
+			Severity:  severity,
+			Code:      code,
+			Message:   fmt.Sprintf(format, args...),
+			NetworkID: n.ID,
+		}
+		if b != nil {
+			d.BlockID = b.ID
+		}
+		diagnostics = append(diagnostics, d)
+	}
+
+	// links
+	for _, l := range sortedLinks(n) {
+		for _, id := range []string{l.From, l.To} {
+			if _, ok := n.Blocks[id]; !ok {
+				diagnostics = append(diagnostics, &Diagnostic{
+					Severity:  SeverityError,
+					Code:      CodeMissingBlock,
+					Message:   fmt.Sprintf("link %s points to missing block %q", l.ID, id),
+					NetworkID: n.ID,
+					LinkID:    l.ID,
+				})
+			}
+		}
+	}
+
+	// blocks
+	var ins, outs []*types.Block
+	blocks := n.SortedBlocksByName()
+	for _, b := range blocks {
+		switch b.Type {
+		case types.InType:
+			ins = append(ins, b)
+		case types.OutType:
+			outs = append(outs, b)
+		case types.NetworkType:
+			if _, ok := project.Networks[b.RefNetwork]; !ok {
+				report(SeverityError, CodeMissingRefNetwork, b, "block %q references missing network %q", b.Name, b.RefNetwork)
+			}
+		}
+
+		spec, ok := types.FindBlockSpec(b.Type)
+		if !ok {
+			report(SeverityError, CodeUnknownBlockType, b, "block %q has unknown type %q", b.Name, b.Type)
+			continue
+		}
+		if spec.Repeatable && b.Repeats <= 0 {
+			report(SeverityError, CodeInvalidRepeats, b, "block %q has non-positive repeats %d", b.Name, b.Repeats)
+		}
+
+		for _, param := range spec.Parameters {
+			v, ok := b.Parameters[param.Name]
+			if !ok {
+				if param.Required {
+					report(SeverityError, CodeMissingParameter, b, "block %q misses parameter %q", b.Name, param.Name)
+				}
+				continue
+			}
+			if _, err := param.Coerce(v); err != nil {
+				report(SeverityError, CodeInvalidParameter, b, "block %q: %s", b.Name, err.Error())
+			}
+		}
+		for _, name := range sortedParameterNames(b.Parameters) {
+			if _, ok := spec.Parameter(name); !ok {
+				report(SeverityWarning, CodeUnknownParameter, b, "block %q has unknown parameter %q", b.Name, name)
+			}
+		}
+	}
+
+	if len(ins) == 0 {
+		report(SeverityError, CodeMissingIn, nil, "network %q has no in block", n.Name)
+	}
+	for _, b := range ins[min(len(ins), 1):] {
+		report(SeverityError, CodeDuplicateIn, b, "network %q has more than one in block", n.Name)
+	}
+	if len(outs) == 0 {
+		report(SeverityError, CodeMissingOut, nil, "network %q has no out block", n.Name)
+	}
+	for _, b := range outs[min(len(outs), 1):] {
+		report(SeverityError, CodeDuplicateOut, b, "network %q has more than one out block", n.Name)
+	}
+
+	// cycles
+	edges := make(map[string][]string)
+	for _, l := range n.Links {
+		_, fromOK := n.Blocks[l.From]
+		_, toOK := n.Blocks[l.To]
+		if fromOK && toOK {
+			edges[l.From] = append(edges[l.From], l.To)
+		}
+	}
+	var ids []string
+	for _, b := range blocks {
+		ids = append(ids, b.ID)
+	}
+	for _, component := range cycles(ids, edges) {
+		var names []string
+		for _, id := range component {
+			names = append(names, n.Blocks[id].Name)
+		}
+		for _, id := range component {
+			report(SeverityError, CodeCycle, n.Blocks[id], "blocks form a cycle: %s", strings.Join(names, ", "))
+		}
+	}
+
+	// reachability
+	reachable := make(map[string]bool)
+	var visit func(id string)
+	visit = func(id string) {
+		if reachable[id] {
+			return
+		}
+		reachable[id] = true
+		for _, to := range edges[id] {
+			visit(to)
+		}
+	}
+	for _, b := range ins {
+		visit(b.ID)
+	}
+	for _, b := range blocks {
+		if len(ins) > 0 && !reachable[b.ID] {
+			report(SeverityWarning, CodeUnreachableBlock, b, "block %q is not reachable from the in block", b.Name)
+		}
+	}
+
+	return diagnostics
+}
+
+// validateReferences reports network blocks that make networks reference
+// themselves directly or through other networks.
+func validateReferences(project *types.Project) []*Diagnostic {
+	networks := sortedNetworks(project)
+
+	var ids []string
+	edges := make(map[string][]string)
+	for _, n := range networks {
+		ids = append(ids, n.ID)
+		for _, b := range n.SortedBlocksByName() {
+			if _, ok := project.Networks[b.RefNetwork]; ok && b.Type == types.NetworkType {
+				edges[n.ID] = append(edges[n.ID], b.RefNetwork)
+			}
+		}
+	}
+
+	var diagnostics []*Diagnostic
+	for _, component := range cycles(ids, edges) {
+		inCycle := make(map[string]bool)
+		var names []string
+		for _, id := range component {
+			inCycle[id] = true
+			names = append(names, project.Networks[id].Name)
+		}
+
+		for _, id := range component {
+			n := project.Networks[id]
+			for _, b := range n.SortedBlocksByName() {
+				if b.Type != types.NetworkType || !inCycle[b.RefNetwork] {
+					continue
+				}
+				diagnostics = append(diagnostics, &Diagnostic{
+					Severity:  SeverityError,
+					Code:      CodeRecursiveNetwork,
+					Message:   fmt.Sprintf("networks reference each other recursively: %s", strings.Join(names, ", ")),
+					NetworkID: n.ID,
+					BlockID:   b.ID,
+				})
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// cycles returns the strongly connected components of the given graph that
+// form cycles, using Tarjan's algorithm.
+func cycles(nodes []string, edges map[string][]string) [][]string {
+	index := 0
+	indices := make(map[string]int)
+	lowLinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+
+	var connect func(v string)
+	connect = func(v string) {
+		indices[v] = index
+		lowLinks[v] = index
+		index++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		selfLoop := false
+		for _, w := range edges[v] {
+			if w == v {
+				selfLoop = true
+			}
+			if _, ok := indices[w]; !ok {
+				connect(w)
+				lowLinks[v] = min(lowLinks[v], lowLinks[w])
+			} else if onStack[w] {
+				lowLinks[v] = min(lowLinks[v], indices[w])
+			}
+		}
+
+		if lowLinks[v] != indices[v] {
+			return
+		}
+
+		var component []string
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			components = append(components, component)
+		}
+	}
+
+	for _, v := range nodes {
+		if _, ok := indices[v]; !ok {
+			connect(v)
+		}
+	}
+
+	return components
+}
+
+func sortedNetworks(project *types.Project) []*types.Network {
+	var networks []*types.Network
+	for _, n := range project.Networks {
+		networks = append(networks, n)
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		if networks[i].Name != networks[j].Name {
+			return networks[i].Name < networks[j].Name
+		}
+		return networks[i].ID < networks[j].ID
+	})
+
+	return networks
+}
+
+func sortedLinks(n *types.Network) []*types.Link {
+	var links []*types.Link
+	for _, l := range n.Links {
+		links = append(links, l)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].ID < links[j].ID
+	})
+
+	return links
+}
+
+func sortedParameterNames(params types.Parameters) []string {
+	var names []string
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
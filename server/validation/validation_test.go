@@ -0,0 +1,126 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/internal/testutil"
+	"github.com/metis-labs/metis-server/server/templates"
+	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/validation"
+)
+
+func TestValidation(t *testing.T) {
+	t.Run("validate builtin templates test", func(t *testing.T) {
+		infos, err := templates.ListBuiltins()
+		assert.NoError(t, err)
+		for _, info := range infos {
+			project, err := templates.FindBuiltin(info.ID.String())
+			assert.NoError(t, err)
+			assert.Empty(t, validation.Validate(project), info.ID)
+		}
+	})
+
+	t.Run("diagnostics test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+
+		relu := types.NewBlock(types.ReLUType, "relu")
+		relu.Repeats = 0
+		unknown := types.NewBlock("Unknown", "unknown")
+		unknown.Repeats = 1
+		ref := types.NewBlock(types.NetworkType, "ref")
+		ref.Repeats = 1
+		ref.RefNetwork = "missing"
+		in := types.NewBlock(types.InType, "in2")
+		out := types.NewBlock(types.OutType, "out2")
+		f.Add(relu, unknown, ref, in, out)
+		f.Link(f.In.ID, f.Out.ID)
+
+		f.Link(relu.ID, unknown.ID)
+		f.Link(unknown.ID, relu.ID)
+		f.Link(relu.ID, "missing")
+
+		codes := make(map[string]int)
+		for _, d := range validation.Validate(f.Project) {
+			assert.Equal(t, f.Network.ID, d.NetworkID)
+			codes[d.Code]++
+		}
+		assert.Equal(t, 1, codes[validation.CodeInvalidRepeats])
+		assert.Equal(t, 1, codes[validation.CodeUnknownBlockType])
+		assert.Equal(t, 1, codes[validation.CodeMissingRefNetwork])
+		assert.Equal(t, 1, codes[validation.CodeDuplicateIn])
+		assert.Equal(t, 1, codes[validation.CodeDuplicateOut])
+		assert.Equal(t, 0, codes[validation.CodeMissingIn])
+		assert.Equal(t, 0, codes[validation.CodeMissingOut])
+		assert.Equal(t, 4, codes[validation.CodeUnreachableBlock])
+		assert.Equal(t, 2, codes[validation.CodeCycle])
+		assert.Equal(t, 1, codes[validation.CodeMissingBlock])
+	})
+
+	t.Run("missing in and out test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+		delete(f.Network.Blocks, f.In.ID)
+		delete(f.Network.Blocks, f.Out.ID)
+		relu := types.NewBlock(types.ReLUType, "relu")
+		relu.Repeats = 1
+		f.Add(relu)
+
+		codes := make(map[string]int)
+		for _, d := range validation.Validate(f.Project) {
+			codes[d.Code]++
+		}
+		assert.Equal(t, 1, codes[validation.CodeMissingIn])
+		assert.Equal(t, 1, codes[validation.CodeMissingOut])
+
+		// blocks are not reported as unreachable if there is no in block.
+		assert.Equal(t, 0, codes[validation.CodeUnreachableBlock])
+	})
+
+	t.Run("recursive network test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+		block := types.NewBlock(types.NetworkType, "self")
+		block.Repeats = 1
+		block.RefNetwork = f.Network.ID
+		f.Add(block)
+
+		diagnostics := validation.Validate(f.Project)
+		assert.True(t, validation.HasError(diagnostics))
+		assert.Equal(t, validation.CodeRecursiveNetwork, diagnostics[len(diagnostics)-1].Code)
+	})
+
+	t.Run("parameter diagnostics test", func(t *testing.T) {
+		f := testutil.NewFixture(t.Name())
+
+		// BachNorm2d is the alias of BatchNorm2d for compatibility.
+		bn := types.NewBlock(types.BachNorm2dType, "bn")
+		bn.Repeats = 1
+		bn.Parameters = types.Parameters{"momentum": 1.5, "unknown": true}
+		f.Add(bn)
+
+		codes := make(map[string]int)
+		for _, d := range validation.Validate(f.Project) {
+			codes[d.Code]++
+		}
+		assert.Equal(t, 0, codes[validation.CodeUnknownBlockType])
+		assert.Equal(t, 1, codes[validation.CodeMissingParameter])
+		assert.Equal(t, 1, codes[validation.CodeInvalidParameter])
+		assert.Equal(t, 1, codes[validation.CodeUnknownParameter])
+	})
+}
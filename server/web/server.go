@@ -19,6 +19,7 @@ package web
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -30,6 +31,8 @@ import (
 
 	"github.com/metis-labs/metis-server/internal/log"
 	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/projects"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
 	"github.com/metis-labs/metis-server/server/types"
 	"github.com/metis-labs/metis-server/server/yorkie"
 )
@@ -42,22 +45,33 @@ const (
 // Config is the configuration for creating a Server instance.
 type Config struct {
 	Port int
+
+	// EditRecordInterval is the minimum interval of recording the edits of the
+	// same user on a project. Edits within the interval only reach Yorkie.
+	EditRecordInterval time.Duration
 }
 
 // Server is a server that processes the web requested such as authentication webhook.
 type Server struct {
-	conf       *Config
-	db         database.Database
-	yorkieConf *yorkie.Config
-	httpServer *http.Server
+	conf          *Config
+	db            database.Database
+	yorkieConf    *yorkie.Config
+	authenticator auth.Authenticator
+	httpServer    *http.Server
 }
 
 // NewServer creates a new instance of Server.
-func NewServer(conf *Config, db database.Database, yorkieConf *yorkie.Config) (*Server, error) {
+func NewServer(
+	conf *Config,
+	db database.Database,
+	yorkieConf *yorkie.Config,
+	authenticator auth.Authenticator,
+) (*Server, error) {
 	server := &Server{
-		conf:       conf,
-		db:         db,
-		yorkieConf: yorkieConf,
+		conf:          conf,
+		db:            db,
+		yorkieConf:    yorkieConf,
+		authenticator: authenticator,
 	}
 
 	r := mux.NewRouter()
@@ -129,25 +143,64 @@ func (s *Server) handleAuth(req *yorkieTypes.AuthWebhookRequest) (*yorkieTypes.A
 
 	switch req.Method {
 	case yorkieTypes.AttachDocument, yorkieTypes.DetachDocument, yorkieTypes.PushPull:
-		docKey, err := key.FromBSONKey(req.Attributes[0].Key)
-		if err != nil {
-			return nil, err
-		}
-
-		project, err := s.db.FindProject(
-			types.CtxWithUserID(context.Background(), req.Token),
-			types.ID(docKey.Document),
-		)
+		// Yorkie forwards the token that the client of Metis sends to the RPC
+		// server, so it is verified in the same way.
+		userID, err := s.authenticator.Authenticate(context.Background(), req.Token)
 		if err != nil {
-			return nil, err
-		}
-
-		if project.Owner != req.Token {
 			return &yorkieTypes.AuthWebhookResponse{
 				Allowed: false,
-				Reason:  "user does not have permission to the document",
+				Reason:  err.Error(),
 			}, nil
 		}
+
+		for _, attr := range req.Attributes {
+			docKey, err := key.FromBSONKey(attr.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			// The project is read on every request, so that changes of its
+			// owner and collaborators take effect immediately.
+			ctx := types.CtxWithUserID(context.Background(), userID)
+			project, err := s.db.FindProject(ctx, types.ID(docKey.Document))
+			if errors.Is(err, database.ErrNotFound) {
+				return &yorkieTypes.AuthWebhookResponse{
+					Allowed: false,
+					Reason:  "user does not have permission to the document",
+				}, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			// Viewers can only attach documents and pull changes, which Yorkie
+			// requests with the read verb.
+			role, ok, err := projects.RoleOf(ctx, s.db, project)
+			if err != nil {
+				return nil, err
+			}
+			if !ok || (attr.Verb != yorkieTypes.Read && !role.CanWrite()) {
+				return &yorkieTypes.AuthWebhookResponse{
+					Allowed: false,
+					Reason:  "user does not have permission to the document",
+				}, nil
+			}
+
+			// Yorkie requests PushPull with the read-write verb only if the
+			// pack has changes, so it is an edit of the user. A failure of
+			// recording it should not block the edit.
+			if req.Method == yorkieTypes.PushPull && attr.Verb == yorkieTypes.ReadWrite {
+				if err := s.db.RecordProjectEdit(
+					ctx,
+					project.ID,
+					userID,
+					time.Now(),
+					s.conf.EditRecordInterval,
+				); err != nil {
+					log.Logger.Error(err)
+				}
+			}
+		}
 	}
 
 	return &yorkieTypes.AuthWebhookResponse{Allowed: true}, nil
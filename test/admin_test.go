@@ -0,0 +1,135 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/metis-labs/metis-server/api"
+	"github.com/metis-labs/metis-server/client"
+)
+
+func TestAdmin(t *testing.T) {
+	cliA, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliA.Close()
+		assert.NoError(t, err)
+	}()
+	admin, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testAdmin})
+	assert.NoError(t, err)
+	defer func() {
+		err = admin.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("admin access test", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, err := cliA.AdminListProjects(ctx, "", "", "")
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+		_, err = cliA.GetSystemStats(ctx)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		_, err = admin.GetSystemStats(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("admin project management test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+
+		projects, err := admin.AdminListUserProjects(ctx, testUserA)
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+		projects, err = admin.AdminListProjects(ctx, "", "created", t.Name())
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+
+		// the admin can delete and restore projects of other users.
+		assert.NoError(t, admin.AdminDeleteProject(ctx, pbProject.Id))
+		projects, err = cliA.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.False(t, containsProject(projects, pbProject.Id))
+		err = admin.AdminDeleteProject(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		assert.NoError(t, admin.AdminRestoreProject(ctx, pbProject.Id))
+		projects, err = cliA.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+
+		// after the transfer, the previous owner cannot delete the project.
+		assert.NoError(t, admin.AdminTransferProject(ctx, pbProject.Id, testUserB))
+		projects, err = admin.AdminListUserProjects(ctx, testUserB)
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+		projects, err = cliA.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.False(t, containsProject(projects, pbProject.Id))
+
+		assert.NoError(t, admin.AdminDeleteProject(ctx, pbProject.Id))
+	})
+
+	t.Run("admin list projects pages test", func(t *testing.T) {
+		ctx := context.Background()
+
+		var ids []string
+		for i := 0; i < 5; i++ {
+			pbProject, err := cliA.CreateProject(ctx, t.Name())
+			assert.NoError(t, err)
+			ids = append(ids, pbProject.Id)
+		}
+
+		var listed []string
+		pageToken := ""
+		for {
+			page, nextPageToken, err := admin.AdminListProjectsPage(ctx, "", "", t.Name(), 2, pageToken)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(page), 2)
+			for _, project := range page {
+				listed = append(listed, project.Id)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		assert.Equal(t, ids, listed)
+
+		_, _, err := admin.AdminListProjectsPage(ctx, "", "", "", 2, "invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+}
+
+// containsProject returns whether the given projects contain the project of
+// the given ID.
+func containsProject(projects []*pb.Project, id string) bool {
+	for _, project := range projects {
+		if project.Id == id {
+			return true
+		}
+	}
+	return false
+}
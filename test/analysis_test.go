@@ -0,0 +1,54 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/client"
+)
+
+func TestAnalysis(t *testing.T) {
+	cli, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cli.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("analyze project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project, err := cli.CreateProjectFromBuiltinTemplate(ctx, t.Name(), "lenet")
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.DeleteProject(ctx, project.Id))
+		}()
+
+		res, err := cli.AnalyzeProject(ctx, project.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(61706), res.Params)
+		assert.Equal(t, int64(416520), res.Macs)
+		assert.Len(t, res.Networks, 1)
+		for _, block := range res.Blocks {
+			assert.Empty(t, block.Error)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/metis-labs/metis-server/client"
+)
+
+func TestAPIKey(t *testing.T) {
+	cli, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cli.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("api key scope test", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, _, err := cli.CreateAPIKey(ctx, t.Name(), "admin", time.Time{})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		_, _, err = cli.CreateAPIKey(ctx, t.Name(), "read", time.Now().Add(-time.Hour))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		apiKey, key, err := cli.CreateAPIKey(ctx, t.Name(), "read", time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, "read", apiKey.Scope)
+		assert.Contains(t, key, apiKey.Prefix)
+		assert.NotEqual(t, key, apiKey.Prefix)
+
+		pbProject, err := cli.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		keyCli, err := client.Dial(testServer.RPCAddr(), client.Option{Token: key})
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, keyCli.Close())
+		}()
+
+		// the read scope can only read the projects of the owner of the key.
+		project, err := keyCli.GetProjectContents(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, pbProject.Id, project.ID)
+		err = keyCli.UpdateProject(ctx, pbProject.Id, "updated")
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+		_, _, err = keyCli.CreateAPIKey(ctx, t.Name(), "read_write", time.Time{})
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		apiKeys, err := cli.ListAPIKeys(ctx)
+		assert.NoError(t, err)
+		var lastUsedAt *timestamppb.Timestamp
+		for _, k := range apiKeys {
+			if k.Id == apiKey.Id {
+				lastUsedAt = k.LastUsedAt
+			}
+		}
+		assert.NotNil(t, lastUsedAt)
+
+		// the time of the use within the interval is not recorded again.
+		_, err = keyCli.GetProjectContents(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		apiKeys, err = cli.ListAPIKeys(ctx)
+		assert.NoError(t, err)
+		for _, k := range apiKeys {
+			if k.Id == apiKey.Id {
+				assert.True(t, lastUsedAt.AsTime().Equal(k.LastUsedAt.AsTime()))
+			}
+		}
+
+		assert.NoError(t, cli.RevokeAPIKey(ctx, apiKey.Id))
+		_, err = keyCli.GetProjectContents(ctx, pbProject.Id)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("expired api key test", func(t *testing.T) {
+		ctx := context.Background()
+
+		apiKey, key, err := cli.CreateAPIKey(ctx, t.Name(), "read_write", time.Now().Add(time.Second))
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.RevokeAPIKey(ctx, apiKey.Id))
+		}()
+
+		keyCli, err := client.Dial(testServer.RPCAddr(), client.Option{Token: key})
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, keyCli.Close())
+		}()
+
+		_, err = keyCli.ListProjects(ctx)
+		assert.NoError(t, err)
+
+		time.Sleep(time.Second)
+		_, err = keyCli.ListProjects(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/metis-labs/metis-server/api"
+)
+
+func TestAuth(t *testing.T) {
+	t.Run("method policy test", func(t *testing.T) {
+		ctx := context.Background()
+		conn, err := grpc.Dial(testServer.RPCAddr(), grpc.WithInsecure())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+
+		// the health check is public, so it does not require a token.
+		res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status)
+
+		_, err = pb.NewMetisClient(conn).ListProjects(ctx, &pb.ListProjectsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/client"
+)
+
+func TestCodegen(t *testing.T) {
+	cli, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cli.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("generate code test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project, err := cli.CreateProjectFromBuiltinTemplate(ctx, t.Name(), "resnet18-basic-block")
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.DeleteProject(ctx, project.Id))
+		}()
+
+		code, err := cli.GenerateCode(ctx, project.Id)
+		assert.NoError(t, err)
+		assert.Contains(t, code, "import torch.nn as nn")
+		assert.Contains(t, code, "class BasicBlock(nn.Module):")
+		assert.Contains(t, code, "self.layer1 = nn.Sequential(*[BasicBlock() for _ in range(2)])")
+		assert.Contains(t, code, "relu2 = self.relu2(bn2 + x)")
+	})
+}
@@ -0,0 +1,237 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	yorkieTypes "github.com/yorkie-team/yorkie/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metis-labs/metis-server/client"
+	"github.com/metis-labs/metis-server/server"
+)
+
+func TestCollaborator(t *testing.T) {
+	cliA, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliA.Close()
+		assert.NoError(t, err)
+	}()
+	cliB, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserB})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliB.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("add collaborator test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		err = cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "owner")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		err = cliA.AddCollaborator(ctx, pbProject.Id, testUserA, "editor")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		err = cliB.AddCollaborator(ctx, pbProject.Id, testUserB, "editor")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// a viewer can read the project but cannot update it.
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "viewer"))
+		projects, err := cliB.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		err = cliB.UpdateProject(ctx, pbProject.Id, "updated")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// an editor can update the project.
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "editor"))
+		assert.NoError(t, cliB.UpdateProject(ctx, pbProject.Id, "updated"))
+
+		collaborators, err := cliB.ListCollaborators(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Len(t, collaborators, 2)
+		assert.Equal(t, testUserA, collaborators[0].UserId)
+		assert.Equal(t, "owner", collaborators[0].Role)
+		assert.Equal(t, testUserB, collaborators[1].UserId)
+		assert.Equal(t, "editor", collaborators[1].Role)
+	})
+
+	t.Run("remove collaborator test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "editor"))
+		assert.NoError(t, cliA.RemoveCollaborator(ctx, pbProject.Id, testUserB))
+		_, err = cliB.ListCollaborators(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// a collaborator can leave the project.
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "viewer"))
+		assert.NoError(t, cliB.RemoveCollaborator(ctx, pbProject.Id, testUserB))
+		err = cliB.RemoveCollaborator(ctx, pbProject.Id, testUserB)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("transfer project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "viewer"))
+
+		err = cliB.TransferProject(ctx, pbProject.Id, testUserB)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		err = cliA.TransferProject(ctx, pbProject.Id, testUserA)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		err = cliA.TransferProject(ctx, pbProject.Id, "")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		docKey := key.Key{Collection: server.DefaultYorkieCollection, Document: pbProject.Id}
+		assert.True(t, authorize(t, testUserA, docKey, yorkieTypes.ReadWrite))
+		assert.False(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+
+		// the new owner is no longer a collaborator, and the previous owner
+		// loses access to the project.
+		assert.NoError(t, cliA.TransferProject(ctx, pbProject.Id, testUserB))
+		assert.False(t, authorize(t, testUserA, docKey, yorkieTypes.Read))
+		assert.True(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+
+		collaborators, err := cliB.ListCollaborators(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Len(t, collaborators, 1)
+		assert.Equal(t, testUserB, collaborators[0].UserId)
+
+		projects, err := cliB.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		assert.Len(t, projects[0].Transfers, 1)
+		assert.Equal(t, testUserA, projects[0].Transfers[0].From)
+		assert.Equal(t, testUserB, projects[0].Transfers[0].To)
+		assert.Equal(t, testUserA, projects[0].Transfers[0].TransferredBy)
+
+		assert.NoError(t, cliB.DeleteProject(ctx, pbProject.Id))
+	})
+
+	t.Run("auth webhook test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		docKey := key.Key{Collection: server.DefaultYorkieCollection, Document: pbProject.Id}
+		assert.True(t, authorize(t, testUserA, docKey, yorkieTypes.ReadWrite))
+		assert.False(t, authorize(t, testUserB, docKey, yorkieTypes.Read))
+
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "viewer"))
+		assert.True(t, authorize(t, testUserB, docKey, yorkieTypes.Read))
+		assert.False(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "editor"))
+		assert.True(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+	})
+
+	t.Run("last editor test", func(t *testing.T) {
+		ctx := context.Background()
+
+		edited, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, edited.Id))
+		}()
+		assert.NoError(t, cliA.AddCollaborator(ctx, edited.Id, testUserB, "editor"))
+
+		untouched, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, untouched.Id))
+		}()
+
+		// pulling changes is not an edit.
+		docKey := key.Key{Collection: server.DefaultYorkieCollection, Document: edited.Id}
+		assert.True(t, authorize(t, testUserB, docKey, yorkieTypes.Read))
+		project, err := cliA.GetProject(ctx, edited.Id)
+		assert.NoError(t, err)
+		assert.Empty(t, project.LastEditor)
+
+		assert.True(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+		project, err = cliA.GetProject(ctx, edited.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserB, project.LastEditor)
+		assert.True(t, project.UpdatedAt.AsTime().After(untouched.UpdatedAt.AsTime()))
+
+		projects, _, err := cliA.ListProjectsPage(ctx, client.ListProjectsOptions{
+			NameContains: t.Name(),
+			SortBy:       "updated_at",
+			Descending:   true,
+		}, "")
+		assert.NoError(t, err)
+		assert.Len(t, projects, 2)
+		assert.Equal(t, edited.Id, projects[0].Id)
+	})
+}
+
+// authorize sends the authorization webhook request of PushPull to the server
+// and returns whether it is allowed.
+func authorize(t *testing.T, token string, docKey key.Key, verb yorkieTypes.VerbType) bool {
+	reqBody, err := json.Marshal(&yorkieTypes.AuthWebhookRequest{
+		Token:  token,
+		Method: yorkieTypes.PushPull,
+		Attributes: []yorkieTypes.AccessAttribute{{
+			Key:  docKey.BSONKey(),
+			Verb: verb,
+		}},
+	})
+	assert.NoError(t, err)
+
+	res, err := http.Post(
+		fmt.Sprintf("http://%s/auth", testServer.WebAddr()),
+		"application/json",
+		bytes.NewBuffer(reqBody),
+	)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, res.Body.Close())
+	}()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	authRes, err := yorkieTypes.NewAuthWebhookResponse(res.Body)
+	assert.NoError(t, err)
+	return authRes.Allowed
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metis-labs/metis-server/client"
+)
+
+func TestInvite(t *testing.T) {
+	cliA, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliA.Close()
+		assert.NoError(t, err)
+	}()
+	cliB, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserB})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliB.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("accept invite test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		_, err = cliA.CreateInvite(ctx, pbProject.Id, "owner", 1, time.Time{})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		_, err = cliA.CreateInvite(ctx, pbProject.Id, "viewer", 1, time.Now().Add(-time.Hour))
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		_, err = cliB.CreateInvite(ctx, pbProject.Id, "viewer", 1, time.Time{})
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		invite, err := cliA.CreateInvite(ctx, pbProject.Id, "editor", 1, time.Time{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, invite.Token)
+		assert.Equal(t, "editor", invite.Role)
+
+		// the owner can already access the project, so the use is not counted.
+		project, err := cliA.AcceptInvite(ctx, invite.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, pbProject.Id, project.Id)
+
+		project, err = cliB.AcceptInvite(ctx, invite.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, pbProject.Id, project.Id)
+		assert.NoError(t, cliB.UpdateProject(ctx, pbProject.Id, "updated"))
+
+		invites, err := cliA.ListInvites(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Len(t, invites, 1)
+		assert.Equal(t, int32(1), invites[0].Uses)
+
+		// the invite has been used up.
+		assert.NoError(t, cliB.RemoveCollaborator(ctx, pbProject.Id, testUserB))
+		_, err = cliB.AcceptInvite(ctx, invite.Token)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		_, err = cliB.AcceptInvite(ctx, "invalid")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("revoke invite test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		invite, err := cliA.CreateInvite(ctx, pbProject.Id, "viewer", 0, time.Time{})
+		assert.NoError(t, err)
+
+		_, err = cliB.ListInvites(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		err = cliB.RevokeInvite(ctx, invite.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		assert.NoError(t, cliA.RevokeInvite(ctx, invite.Id))
+		_, err = cliB.AcceptInvite(ctx, invite.Token)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		invites, err := cliA.ListInvites(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Empty(t, invites)
+	})
+}
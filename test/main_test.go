@@ -23,7 +23,9 @@ import (
 
 	"github.com/metis-labs/metis-server/server"
 	"github.com/metis-labs/metis-server/server/database/mongodb"
+	"github.com/metis-labs/metis-server/server/purge"
 	"github.com/metis-labs/metis-server/server/rpc"
+	"github.com/metis-labs/metis-server/server/rpc/auth"
 	"github.com/metis-labs/metis-server/server/web"
 	"github.com/metis-labs/metis-server/server/yorkie"
 )
@@ -33,12 +35,15 @@ var testServer *server.Server
 const (
 	testUserA = "KR18401"
 	testUserB = "KR18817"
+	testAdmin = "KR00001"
 )
 
 func TestMain(m *testing.M) {
 	s, err := server.New(&server.Config{
 		RPC: &rpc.Config{
-			Port: server.DefaultRPCPort,
+			Port:                   server.DefaultRPCPort,
+			AdminUserIDs:           []string{testAdmin},
+			APIKeyLastUsedInterval: server.DefaultRPCAPIKeyLastUsedInterval,
 		},
 		Web: &web.Config{
 			Port: server.DefaultWebPort,
@@ -48,6 +53,12 @@ func TestMain(m *testing.M) {
 			WebhookToken: server.DefaultYorkieWebhookToken,
 			Collection:   server.DefaultYorkieCollection,
 		},
+		Auth: &auth.Config{
+			InsecureDev: true,
+		},
+		Purge: &purge.Config{
+			RetentionPeriod: server.DefaultPurgeRetentionPeriod,
+		},
 		Mongo: &mongodb.Config{
 			ConnectionURI:        server.DefaultMongoConnectionURI,
 			ConnectionTimeoutSec: server.DefaultMongoConnectionTimeoutSec,
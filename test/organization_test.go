@@ -0,0 +1,130 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	yorkieTypes "github.com/yorkie-team/yorkie/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metis-labs/metis-server/client"
+	"github.com/metis-labs/metis-server/server"
+)
+
+func TestOrganization(t *testing.T) {
+	cliA, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliA.Close()
+		assert.NoError(t, err)
+	}()
+	cliB, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserB})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliB.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("organization project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		organization, err := cliA.CreateOrganization(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.Len(t, organization.Members, 1)
+		assert.Equal(t, "admin", organization.Members[0].Role)
+
+		_, err = cliB.CreateOrganizationProject(ctx, t.Name(), organization.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		pbProject, err := cliA.CreateOrganizationProject(ctx, t.Name(), organization.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, organization.Id, pbProject.OrganizationId)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		scope := "org:" + organization.Id
+		projects, err := cliA.ListProjectsInScope(ctx, scope)
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		projects, err = cliA.ListProjectsInScope(ctx, "personal")
+		assert.NoError(t, err)
+		for _, project := range projects {
+			assert.NotEqual(t, pbProject.Id, project.Id)
+		}
+		_, err = cliA.ListProjectsInScope(ctx, "invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		projects, err = cliB.ListProjectsInScope(ctx, scope)
+		assert.NoError(t, err)
+		assert.Empty(t, projects)
+
+		// members can edit the projects of the organization.
+		docKey := key.Key{Collection: server.DefaultYorkieCollection, Document: pbProject.Id}
+		assert.False(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+		assert.NoError(t, cliA.AddOrganizationMember(ctx, organization.Id, testUserB, "member"))
+		assert.True(t, authorize(t, testUserB, docKey, yorkieTypes.ReadWrite))
+
+		projects, err = cliB.ListProjectsInScope(ctx, scope)
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		assert.NoError(t, cliB.UpdateProject(ctx, pbProject.Id, "updated"))
+
+		// members cannot manage collaborators, but admins can.
+		err = cliB.AddCollaborator(ctx, pbProject.Id, "KR00000", "viewer")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		assert.NoError(t, cliA.AddOrganizationMember(ctx, organization.Id, testUserB, "admin"))
+		assert.NoError(t, cliB.AddCollaborator(ctx, pbProject.Id, "KR00000", "viewer"))
+	})
+
+	t.Run("organization member test", func(t *testing.T) {
+		ctx := context.Background()
+
+		organization, err := cliA.CreateOrganization(ctx, t.Name())
+		assert.NoError(t, err)
+
+		err = cliA.AddOrganizationMember(ctx, organization.Id, testUserB, "owner")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		err = cliB.AddOrganizationMember(ctx, organization.Id, testUserB, "member")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		assert.NoError(t, cliA.AddOrganizationMember(ctx, organization.Id, testUserB, "member"))
+		organizations, err := cliB.ListOrganizations(ctx)
+		assert.NoError(t, err)
+		var found bool
+		for _, org := range organizations {
+			if org.Id == organization.Id {
+				found = true
+				assert.Len(t, org.Members, 2)
+			}
+		}
+		assert.True(t, found)
+
+		// the last admin cannot leave the organization.
+		err = cliA.RemoveOrganizationMember(ctx, organization.Id, testUserA)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		assert.NoError(t, cliB.RemoveOrganizationMember(ctx, organization.Id, testUserB))
+		err = cliB.RemoveOrganizationMember(ctx, organization.Id, testUserB)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+}
@@ -19,12 +19,20 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/metis-labs/metis-server/client"
+	"github.com/metis-labs/metis-server/server"
+	"github.com/metis-labs/metis-server/server/database/mongodb"
+	"github.com/metis-labs/metis-server/server/types"
 )
 
 func TestProject(t *testing.T) {
@@ -63,6 +71,144 @@ func TestProject(t *testing.T) {
 		assert.NotEmpty(t, projects)
 	})
 
+	t.Run("list project pages test", func(t *testing.T) {
+		ctx := context.Background()
+
+		var ids []string
+		for _, suffix := range []string{"c", "a", "b"} {
+			pbProject, err := cliA.CreateProject(ctx, t.Name()+"-"+suffix)
+			assert.NoError(t, err)
+			ids = append(ids, pbProject.Id)
+		}
+		defer func() {
+			for _, id := range ids {
+				assert.NoError(t, cliA.DeleteProject(ctx, id))
+			}
+		}()
+
+		opts := client.ListProjectsOptions{
+			PageSize:     2,
+			SortBy:       "name",
+			NameContains: t.Name(),
+		}
+		projects, token, err := cliA.ListProjectsPage(ctx, opts, "")
+		assert.NoError(t, err)
+		assert.Len(t, projects, 2)
+		assert.Equal(t, t.Name()+"-a", projects[0].Name)
+		assert.Equal(t, t.Name()+"-b", projects[1].Name)
+		assert.NotEmpty(t, token)
+
+		projects, token, err = cliA.ListProjectsPage(ctx, opts, token)
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		assert.Equal(t, t.Name()+"-c", projects[0].Name)
+		assert.Empty(t, token)
+
+		_, _, err = cliA.ListProjectsPage(ctx, opts, "invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		opts.SortBy = "owner"
+		_, _, err = cliA.ListProjectsPage(ctx, opts, "")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// the iterator walks all pages in the given order.
+		var names []string
+		it := cliA.IterateProjects(client.ListProjectsOptions{
+			PageSize:     1,
+			SortBy:       "created_at",
+			Descending:   true,
+			NameContains: t.Name(),
+		})
+		for {
+			project, err := it.Next(ctx)
+			if err == client.Done {
+				break
+			}
+			assert.NoError(t, err)
+			names = append(names, project.Name)
+		}
+		assert.Equal(t, []string{t.Name() + "-b", t.Name() + "-a", t.Name() + "-c"}, names)
+	})
+
+	t.Run("legacy project pages test", func(t *testing.T) {
+		ctx := context.Background()
+		conf := &mongodb.Config{
+			ConnectionURI:        server.DefaultMongoConnectionURI,
+			ConnectionTimeoutSec: server.DefaultMongoConnectionTimeoutSec,
+			PingTimeoutSec:       server.DefaultMongoPingTimeoutSec,
+			Database:             server.DefaultMongoDatabase,
+		}
+
+		// projects written by older versions do not have updated_at.
+		mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(conf.ConnectionURI))
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, mongoClient.Disconnect(ctx))
+		}()
+		var ids []string
+		for i := 0; i < 2; i++ {
+			result, err := mongoClient.Database(conf.Database).Collection("projects").InsertOne(ctx, bson.M{
+				"name":       t.Name(),
+				"owner":      testUserA,
+				"status":     "created",
+				"created_at": time.Now(),
+			})
+			assert.NoError(t, err)
+			ids = append(ids, result.InsertedID.(primitive.ObjectID).Hex())
+		}
+		defer func() {
+			for _, id := range ids {
+				assert.NoError(t, cliA.DeleteProject(ctx, id))
+			}
+		}()
+
+		// dialing the database migrates them.
+		db := mongodb.NewClient(conf)
+		assert.NoError(t, db.Dial(ctx))
+		defer func() {
+			assert.NoError(t, db.Close(ctx))
+		}()
+
+		var listed []string
+		it := cliA.IterateProjects(client.ListProjectsOptions{
+			PageSize:     1,
+			SortBy:       "updated_at",
+			NameContains: t.Name(),
+		})
+		for {
+			project, err := it.Next(ctx)
+			if err == client.Done {
+				break
+			}
+			assert.NoError(t, err)
+			listed = append(listed, project.Id)
+		}
+		assert.ElementsMatch(t, ids, listed)
+	})
+
+	t.Run("get project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		project, err := cliA.GetProject(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, t.Name(), project.Name)
+		assert.Equal(t, testUserA, project.Owner)
+		assert.Equal(t, "created", project.Status)
+		assert.NotNil(t, project.UpdatedAt)
+		assert.Equal(t, server.DefaultYorkieCollection, project.DocumentKey.Collection)
+		assert.Equal(t, pbProject.Id, project.DocumentKey.Document)
+
+		_, err = cliB.GetProject(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		_, err = cliA.GetProject(ctx, "invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+
 	t.Run("update project test", func(t *testing.T) {
 		ctxA := context.Background()
 
@@ -88,6 +234,60 @@ func TestProject(t *testing.T) {
 		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
 	})
 
+	t.Run("project metadata test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+		assert.NoError(t, cliA.AddCollaborator(ctx, pbProject.Id, testUserB, "viewer"))
+
+		// updates of different fields do not clobber each other, and they are
+		// not edits of the contents.
+		assert.NoError(t, cliA.SetProjectDescription(ctx, pbProject.Id, "# description"))
+		assert.NoError(t, cliA.SetProjectTags(ctx, pbProject.Id, []string{" vision ", "", "vision", "cnn"}))
+		project, err := cliA.GetProject(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, t.Name(), project.Name)
+		assert.Equal(t, "# description", project.Description)
+		assert.Equal(t, []string{"vision", "cnn"}, project.Tags)
+		assert.False(t, project.Starred)
+		assert.Equal(t, pbProject.UpdatedAt.AsTime(), project.UpdatedAt.AsTime())
+		assert.Empty(t, project.LastEditor)
+
+		// viewers can star the project, but cannot edit it.
+		assert.NoError(t, cliB.StarProject(ctx, pbProject.Id, true))
+		err = cliB.SetProjectTags(ctx, pbProject.Id, nil)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		project, err = cliB.GetProject(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.True(t, project.Starred)
+		project, err = cliA.GetProject(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.False(t, project.Starred)
+
+		projects, _, err := cliB.ListProjectsPage(ctx, client.ListProjectsOptions{Starred: true}, "")
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+		projects, _, err = cliA.ListProjectsPage(ctx, client.ListProjectsOptions{Starred: true}, "")
+		assert.NoError(t, err)
+		assert.False(t, containsProject(projects, pbProject.Id))
+		projects, _, err = cliA.ListProjectsPage(ctx, client.ListProjectsOptions{Tag: "cnn"}, "")
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+		projects, _, err = cliA.ListProjectsPage(ctx, client.ListProjectsOptions{Tag: "rnn"}, "")
+		assert.NoError(t, err)
+		assert.False(t, containsProject(projects, pbProject.Id))
+
+		assert.NoError(t, cliB.StarProject(ctx, pbProject.Id, false))
+		project, err = cliB.GetProject(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		assert.False(t, project.Starred)
+	})
+
 	t.Run("delete project test", func(t *testing.T) {
 		ctxA := context.Background()
 
@@ -101,4 +301,121 @@ func TestProject(t *testing.T) {
 		err = cliA.DeleteProject(ctxA, pbProject.Id)
 		assert.NoError(t, err)
 	})
+
+	t.Run("create project from template test", func(t *testing.T) {
+		ctxA := context.Background()
+
+		_, err := cliA.CreateProjectFromTemplate(ctxA, t.Name(), "invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		_, err = cliA.CreateProjectFromTemplate(ctxA, t.Name(), "000000000000000000000000")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+	t.Run("get project contents test", func(t *testing.T) {
+		ctxA := context.Background()
+
+		pbProject, err := cliA.CreateProjectFromBuiltinTemplate(ctxA, t.Name(), "resnet18-basic-block")
+		assert.NoError(t, err)
+		defer func() {
+			err = cliA.DeleteProject(ctxA, pbProject.Id)
+			assert.NoError(t, err)
+		}()
+
+		project, err := cliA.GetProjectContents(ctxA, pbProject.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, pbProject.Id, project.ID)
+		assert.Len(t, project.Networks, 2)
+
+		var mainNetwork, basicBlock *types.Network
+		for _, network := range project.Networks {
+			if network.Name == "Main" {
+				mainNetwork = network
+			} else {
+				basicBlock = network
+			}
+		}
+		assert.NotNil(t, mainNetwork)
+		assert.NotNil(t, basicBlock)
+		assert.Len(t, basicBlock.Links, 8)
+		for _, block := range mainNetwork.Blocks {
+			if block.Type == types.NetworkType {
+				assert.Equal(t, basicBlock.ID, block.RefNetwork)
+				assert.Equal(t, 2, block.Repeats)
+			}
+			if block.Type == types.Conv2dType {
+				assert.Equal(t, float64(64), block.Parameters["out_channels"])
+			}
+		}
+
+		_, err = cliB.GetProjectContents(ctxA, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+	t.Run("duplicate project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProjectFromBuiltinTemplate(ctx, t.Name(), "resnet18-basic-block")
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+		}()
+
+		_, err = cliB.DuplicateProject(ctx, pbProject.Id, "")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		copied, err := cliA.DuplicateProject(ctx, pbProject.Id, "")
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, copied.Id))
+		}()
+		assert.Equal(t, t.Name()+" (copy)", copied.Name)
+		assert.Equal(t, pbProject.Id, copied.ForkedFrom)
+
+		source, err := cliA.GetProjectContents(ctx, pbProject.Id)
+		assert.NoError(t, err)
+		project, err := cliA.GetProjectContents(ctx, copied.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, copied.Id, project.ID)
+		assert.Len(t, project.Networks, len(source.Networks))
+
+		// all IDs are renewed, and references are remapped to the new IDs.
+		for id, network := range project.Networks {
+			assert.NotContains(t, source.Networks, id)
+			for _, block := range network.Blocks {
+				if block.Type == types.NetworkType {
+					assert.Contains(t, project.Networks, block.RefNetwork)
+				}
+			}
+			for _, link := range network.Links {
+				assert.Contains(t, network.Blocks, link.From)
+				assert.Contains(t, network.Blocks, link.To)
+			}
+		}
+	})
+
+	t.Run("restore project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+
+		deleted, err := cliA.ListDeletedProjects(ctx)
+		assert.NoError(t, err)
+		assert.True(t, containsProject(deleted, pbProject.Id))
+		deleted, err = cliB.ListDeletedProjects(ctx)
+		assert.NoError(t, err)
+		assert.False(t, containsProject(deleted, pbProject.Id))
+
+		// only the owner can restore the project.
+		err = cliB.RestoreProject(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+		assert.NoError(t, cliA.RestoreProject(ctx, pbProject.Id))
+		err = cliA.RestoreProject(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		projects, err := cliA.ListProjects(ctx)
+		assert.NoError(t, err)
+		assert.True(t, containsProject(projects, pbProject.Id))
+		assert.NoError(t, cliA.DeleteProject(ctx, pbProject.Id))
+	})
 }
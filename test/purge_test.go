@@ -0,0 +1,128 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metis-labs/metis-server/client"
+	"github.com/metis-labs/metis-server/server"
+	"github.com/metis-labs/metis-server/server/database"
+	"github.com/metis-labs/metis-server/server/database/mongodb"
+	"github.com/metis-labs/metis-server/server/purge"
+	"github.com/metis-labs/metis-server/server/types"
+	"github.com/metis-labs/metis-server/server/yorkie"
+)
+
+func TestPurge(t *testing.T) {
+	cli, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cli.Close()
+		assert.NoError(t, err)
+	}()
+
+	db := mongodb.NewClient(&mongodb.Config{
+		ConnectionURI:        server.DefaultMongoConnectionURI,
+		ConnectionTimeoutSec: server.DefaultMongoConnectionTimeoutSec,
+		PingTimeoutSec:       server.DefaultMongoPingTimeoutSec,
+		Database:             server.DefaultMongoDatabase,
+	})
+	assert.NoError(t, db.Dial(context.Background()))
+	defer func() {
+		assert.NoError(t, db.Close(context.Background()))
+	}()
+	yorkieConf := &yorkie.Config{
+		RPCAddr:      server.DefaultYorkieRPCAddr,
+		WebhookToken: server.DefaultYorkieWebhookToken,
+		Collection:   server.DefaultYorkieCollection,
+	}
+
+	t.Run("purge test", func(t *testing.T) {
+		ctx := context.Background()
+
+		pbProject, err := cli.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.DeleteProject(ctx, pbProject.Id))
+
+		// projects within the retention period are kept.
+		purged, err := purge.Purge(ctx, db, yorkieConf, server.DefaultPurgeRetentionPeriod, false)
+		assert.NoError(t, err)
+		assert.False(t, containsProjectInfo(purged, pbProject.Id))
+
+		purged, err = purge.Purge(ctx, db, yorkieConf, 0, true)
+		assert.NoError(t, err)
+		assert.True(t, containsProjectInfo(purged, pbProject.Id))
+		deleted, err := cli.ListDeletedProjects(ctx)
+		assert.NoError(t, err)
+		assert.True(t, containsProject(deleted, pbProject.Id))
+
+		purged, err = purge.Purge(ctx, db, yorkieConf, 0, false)
+		assert.NoError(t, err)
+		assert.True(t, containsProjectInfo(purged, pbProject.Id))
+		err = cli.RestoreProject(ctx, pbProject.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("claim for purge test", func(t *testing.T) {
+		ctx := context.Background()
+
+		restored, err := cli.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.DeleteProject(ctx, restored.Id))
+		claimed, err := cli.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.DeleteProject(ctx, claimed.Id))
+
+		// a restored project cannot be claimed, and a claimed project cannot
+		// be restored.
+		assert.NoError(t, cli.RestoreProject(ctx, restored.Id))
+		defer func() {
+			assert.NoError(t, cli.DeleteProject(ctx, restored.Id))
+		}()
+		err = db.ClaimProjectForPurge(ctx, types.ID(restored.Id), time.Now())
+		assert.True(t, errors.Is(err, database.ErrNotFound))
+
+		assert.NoError(t, db.ClaimProjectForPurge(ctx, types.ID(claimed.Id), time.Now()))
+		err = cli.RestoreProject(ctx, claimed.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// a claimed project is purged in the next run.
+		purged, err := purge.Purge(ctx, db, yorkieConf, 0, false)
+		assert.NoError(t, err)
+		assert.True(t, containsProjectInfo(purged, claimed.Id))
+		assert.False(t, containsProjectInfo(purged, restored.Id))
+	})
+}
+
+// containsProjectInfo returns whether the given projects contain the project
+// of the given ID.
+func containsProjectInfo(projects []*types.ProjectInfo, id string) bool {
+	for _, project := range projects {
+		if project.ID.String() == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/metis-labs/metis-server/client"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestTemplate(t *testing.T) {
+	cliA, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliA.Close()
+		assert.NoError(t, err)
+	}()
+	cliB, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserB})
+	assert.NoError(t, err)
+	defer func() {
+		err = cliB.Close()
+		assert.NoError(t, err)
+	}()
+
+	contents, err := json.Marshal(types.NewProject("", "template"))
+	assert.NoError(t, err)
+
+	t.Run("template visibility test", func(t *testing.T) {
+		ctx := context.Background()
+
+		private, err := cliA.CreateTemplate(ctx, t.Name(), string(contents), false)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteTemplate(ctx, private.Id))
+		}()
+
+		public, err := cliA.CreateTemplate(ctx, t.Name(), string(contents), true)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteTemplate(ctx, public.Id))
+		}()
+
+		_, err = cliB.GetTemplate(ctx, private.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		template, err := cliB.GetTemplate(ctx, public.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserA, template.Owner)
+
+		err = cliB.UpdateTemplate(ctx, public.Id, "updated", string(contents), true)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		err = cliB.DeleteTemplate(ctx, public.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("update template test", func(t *testing.T) {
+		ctx := context.Background()
+
+		template, err := cliA.CreateTemplate(ctx, t.Name(), string(contents), false)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteTemplate(ctx, template.Id))
+		}()
+
+		err = cliA.UpdateTemplate(ctx, template.Id, "updated", string(contents), false)
+		assert.NoError(t, err)
+
+		err = cliA.UpdateTemplate(ctx, template.Id, "updated", "invalid", false)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		_, err = cliA.CreateTemplate(ctx, t.Name(), "{}", false)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
+
+	t.Run("create project from template test", func(t *testing.T) {
+		ctx := context.Background()
+
+		template, err := cliA.CreateTemplate(ctx, t.Name(), string(contents), false)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteTemplate(ctx, template.Id))
+		}()
+
+		project, err := cliA.CreateProjectFromTemplate(ctx, t.Name(), template.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, t.Name(), project.Name)
+		assert.NoError(t, cliA.DeleteProject(ctx, project.Id))
+
+		_, err = cliB.CreateProjectFromTemplate(ctx, t.Name(), template.Id)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+	t.Run("save project as template test", func(t *testing.T) {
+		ctx := context.Background()
+
+		project, err := cliA.CreateProject(ctx, t.Name())
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteProject(ctx, project.Id))
+		}()
+
+		template, err := cliA.SaveProjectAsTemplate(ctx, project.Id, t.Name(), false, true)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cliA.DeleteTemplate(ctx, template.Id))
+		}()
+
+		saved := &types.Project{}
+		assert.NoError(t, json.Unmarshal([]byte(template.Contents), saved))
+		assert.Len(t, saved.Networks, 1)
+		for _, network := range saved.Networks {
+			assert.Len(t, network.Blocks, 2)
+			for _, block := range network.Blocks {
+				assert.Nil(t, block.Position)
+			}
+		}
+
+		_, err = cliB.SaveProjectAsTemplate(ctx, project.Id, t.Name(), false, true)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+	t.Run("builtin template test", func(t *testing.T) {
+		ctx := context.Background()
+
+		builtins, err := cliA.ListBuiltinTemplates(ctx)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, builtins)
+
+		for _, builtin := range builtins {
+			project, err := cliA.CreateProjectFromBuiltinTemplate(ctx, t.Name(), builtin.Id)
+			assert.NoError(t, err)
+			assert.NoError(t, cliA.DeleteProject(ctx, project.Id))
+		}
+
+		_, err = cliA.CreateProjectFromBuiltinTemplate(ctx, t.Name(), "unknown")
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+}
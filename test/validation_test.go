@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021-present NAVER Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/metis-labs/metis-server/client"
+	"github.com/metis-labs/metis-server/server/types"
+)
+
+func TestValidation(t *testing.T) {
+	cli, err := client.Dial(testServer.RPCAddr(), client.Option{UserID: testUserA})
+	assert.NoError(t, err)
+	defer func() {
+		err = cli.Close()
+		assert.NoError(t, err)
+	}()
+
+	t.Run("validate project test", func(t *testing.T) {
+		ctx := context.Background()
+
+		for _, name := range []string{"lenet", "resnet18-basic-block"} {
+			project, err := cli.CreateProjectFromBuiltinTemplate(ctx, t.Name(), name)
+			assert.NoError(t, err)
+
+			valid, diagnostics, err := cli.ValidateProject(ctx, project.Id)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+			assert.Empty(t, diagnostics)
+
+			assert.NoError(t, cli.DeleteProject(ctx, project.Id))
+		}
+	})
+
+	t.Run("list block types test", func(t *testing.T) {
+		blockTypes, err := cli.ListBlockTypes(context.Background())
+		assert.NoError(t, err)
+
+		found := false
+		for _, blockType := range blockTypes {
+			if blockType.Type == string(types.BatchNorm2dType) {
+				found = true
+				assert.Equal(t, []string{string(types.BachNorm2dType)}, blockType.Aliases)
+				assert.Equal(t, "BatchNorm2d", blockType.TorchClass)
+			}
+		}
+		assert.True(t, found)
+	})
+}